@@ -0,0 +1,115 @@
+package acctest
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+)
+
+const (
+	dockerManifestMediaType = "application/vnd.docker.distribution.manifest.v2+json"
+	dockerConfigMediaType   = "application/vnd.docker.container.image.v1+json"
+	dockerLayerMediaType    = "application/vnd.docker.image.rootfs.diff.tar.gzip"
+)
+
+type dockerDescriptor struct {
+	MediaType string `json:"mediaType"`
+	Size      int    `json:"size"`
+	Digest    string `json:"digest"`
+}
+
+type dockerManifest struct {
+	SchemaVersion int                `json:"schemaVersion"`
+	MediaType     string             `json:"mediaType"`
+	Config        dockerDescriptor   `json:"config"`
+	Layers        []dockerDescriptor `json:"layers"`
+}
+
+func dockerDigest(content []byte) string {
+	return fmt.Sprintf("sha256:%x", sha256.Sum256(content))
+}
+
+// uploadDockerBlob uploads the blob with the Docker registry API and returns its digest
+func uploadDockerBlob(t *testing.T, c *resty.Client, repo, image string, content []byte) string {
+	digest := dockerDigest(content)
+
+	resp, err := c.R().Post(fmt.Sprintf("artifactory/api/docker/%s/v2/%s/blobs/uploads/", repo, image))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the upload location is relative to the registry root
+	location := resp.Header().Get("Location")
+	if strings.HasPrefix(location, "/v2/") {
+		location = fmt.Sprintf("artifactory/api/docker/%s%s", repo, location)
+	}
+
+	_, err = c.R().
+		SetQueryParam("digest", digest).
+		SetHeader("Content-Type", "application/octet-stream").
+		SetBody(content).
+		Put(location)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return digest
+}
+
+// PushDockerImage pushes a minimal single layer image with the tags to a local Docker repository, with the Docker
+// registry API, and returns the digest of its manifest
+func PushDockerImage(t *testing.T, repo, image string, tags ...string) string {
+	restyClient := GetTestResty(t)
+
+	// an empty tar archive is two blocks of 512 zero bytes
+	emptyTar := make([]byte, 1024)
+	var layer bytes.Buffer
+	gz := gzip.NewWriter(&layer)
+	if _, err := gz.Write(emptyTar); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	config := []byte(fmt.Sprintf(
+		`{"architecture":"amd64","os":"linux","config":{},"rootfs":{"type":"layers","diff_ids":["%s"]}}`,
+		dockerDigest(emptyTar),
+	))
+
+	manifest, err := json.Marshal(dockerManifest{
+		SchemaVersion: 2,
+		MediaType:     dockerManifestMediaType,
+		Config: dockerDescriptor{
+			MediaType: dockerConfigMediaType,
+			Size:      len(config),
+			Digest:    uploadDockerBlob(t, restyClient, repo, image, config),
+		},
+		Layers: []dockerDescriptor{{
+			MediaType: dockerLayerMediaType,
+			Size:      layer.Len(),
+			Digest:    uploadDockerBlob(t, restyClient, repo, image, layer.Bytes()),
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tag := range tags {
+		_, err := restyClient.R().
+			SetHeader("Content-Type", dockerManifestMediaType).
+			SetBody(manifest).
+			Put(fmt.Sprintf("artifactory/api/docker/%s/v2/%s/manifests/%s", repo, image, tag))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dockerDigest(manifest)
+}
@@ -0,0 +1,206 @@
+package datasource
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/artifactory/resource/repository"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+const (
+	DockerManifestV2MediaType     = "application/vnd.docker.distribution.manifest.v2+json"
+	DockerManifestListMediaType   = "application/vnd.docker.distribution.manifest.list.v2+json"
+	OciImageManifestMediaType     = "application/vnd.oci.image.manifest.v1+json"
+	OciImageIndexMediaType        = "application/vnd.oci.image.index.v1+json"
+	dockerContentDigestHeaderName = "Docker-Content-Digest"
+)
+
+type DockerDescriptor struct {
+	MediaType string `json:"mediaType"`
+	Digest    string `json:"digest"`
+	Size      int    `json:"size"`
+	Platform  *struct {
+		Architecture string `json:"architecture"`
+		OS           string `json:"os"`
+		Variant      string `json:"variant"`
+	} `json:"platform,omitempty"`
+}
+
+// DockerManifest holds both image manifests (config & layers) and multi-arch indexes (manifests)
+type DockerManifest struct {
+	SchemaVersion int                `json:"schemaVersion"`
+	MediaType     string             `json:"mediaType"`
+	Config        *DockerDescriptor  `json:"config,omitempty"`
+	Layers        []DockerDescriptor `json:"layers"`
+	Manifests     []DockerDescriptor `json:"manifests"`
+}
+
+func (m DockerManifest) IsIndex() bool {
+	return m.MediaType == DockerManifestListMediaType || m.MediaType == OciImageIndexMediaType || len(m.Manifests) > 0
+}
+
+func ArtifactoryDockerImage() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDockerImageRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: repository.RepoKeyValidator,
+				Description:  "Docker repository key.",
+			},
+			"image": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "Name of the image, including its namespace if any (e.g. `library/busybox`).",
+			},
+			"tag": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "Tag of the image.",
+			},
+			"media_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Media type of the manifest.",
+			},
+			"digest": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Digest of the manifest, to be used to pull the image by digest.",
+			},
+			"config_digest": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Digest of the image config. Empty for multi-arch indexes.",
+			},
+			"size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Size in bytes of the config and layers of the image, or of the platform manifests for multi-arch indexes.",
+			},
+			"platforms": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of platforms of a multi-arch index. Empty for single platform images.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"os": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"architecture": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"variant": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"digest": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDockerImageRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	repo := d.Get("repository").(string)
+	image := d.Get("image").(string)
+	tag := d.Get("tag").(string)
+
+	resp, err := m.(*resty.Client).R().
+		SetHeader("Accept", strings.Join([]string{
+			DockerManifestListMediaType,
+			DockerManifestV2MediaType,
+			OciImageIndexMediaType,
+			OciImageManifestMediaType,
+		}, ", ")).
+		Get(fmt.Sprintf(DockerV2Endpoint+"/manifests/%s", repo, image, tag))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	manifest := DockerManifest{}
+	if err := json.Unmarshal(resp.Body(), &manifest); err != nil {
+		return diag.Errorf("failed to parse manifest of %s:%s %s", image, tag, err)
+	}
+
+	digest := resp.Header().Get(dockerContentDigestHeaderName)
+	if digest == "" {
+		sum := sha256.Sum256(resp.Body())
+		digest = "sha256:" + hex.EncodeToString(sum[:])
+	}
+
+	if manifest.MediaType == "" {
+		manifest.MediaType = resp.Header().Get("Content-Type")
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s:%s", repo, image, tag))
+
+	return packDockerImage(manifest, digest, d)
+}
+
+func packDockerImage(manifest DockerManifest, digest string, d *schema.ResourceData) diag.Diagnostics {
+	setValue := util.MkLens(d)
+
+	var size int
+	configDigest := ""
+	platforms := []interface{}{}
+
+	if manifest.IsIndex() {
+		for _, platformManifest := range manifest.Manifests {
+			size += platformManifest.Size
+			platform := map[string]interface{}{
+				"digest": platformManifest.Digest,
+				"size":   platformManifest.Size,
+			}
+			if platformManifest.Platform != nil {
+				platform["os"] = platformManifest.Platform.OS
+				platform["architecture"] = platformManifest.Platform.Architecture
+				platform["variant"] = platformManifest.Platform.Variant
+			}
+			platforms = append(platforms, platform)
+		}
+	} else {
+		if manifest.Config != nil {
+			configDigest = manifest.Config.Digest
+			size += manifest.Config.Size
+		}
+		for _, layer := range manifest.Layers {
+			size += layer.Size
+		}
+	}
+
+	setValue("media_type", manifest.MediaType)
+	setValue("digest", digest)
+	setValue("config_digest", configDigest)
+	setValue("size", size)
+	errors := setValue("platforms", platforms)
+
+	if errors != nil && len(errors) > 0 {
+		return diag.Errorf("failed to pack docker image %q", errors)
+	}
+
+	return nil
+}
@@ -0,0 +1,245 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/artifactory/resource/repository"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+const DockerV2Endpoint = "artifactory/api/docker/%s/v2/%s"
+
+type DockerTagsList struct {
+	Name string   `json:"name"`
+	Tags []string `json:"tags"`
+}
+
+func ArtifactoryDockerTags() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDockerTagsRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: repository.RepoKeyValidator,
+				Description:  "Docker repository key.",
+			},
+			"image": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "Name of the image, including its namespace if any (e.g. `library/busybox`).",
+			},
+			"filter": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "Regular expression used to filter the tags. Only matching tags are returned.",
+			},
+			"sort_by_semver": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "When set, tags are sorted by semantic version in ascending order. Tags that are not semantic versions are placed first, in lexical order. Default value is 'false'.",
+			},
+			"tags": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "List of tags of the image.",
+			},
+		},
+	}
+}
+
+func dataSourceDockerTagsRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	repo := d.Get("repository").(string)
+	image := d.Get("image").(string)
+
+	tags, err := FetchDockerTags(m.(*resty.Client), repo, image)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if filter, ok := d.GetOk("filter"); ok {
+		tags = filterTags(tags, regexp.MustCompile(filter.(string)))
+	}
+
+	if d.Get("sort_by_semver").(bool) {
+		SortTagsBySemver(tags)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", repo, image))
+
+	setValue := util.MkLens(d)
+	errors := setValue("tags", tags)
+	if errors != nil && len(errors) > 0 {
+		return diag.Errorf("failed to pack docker tags %q", errors)
+	}
+
+	return nil
+}
+
+var linkNextRegex = regexp.MustCompile(`<([^>]+)>\s*;\s*rel="?next"?`)
+
+// nextPageQuery returns the query parameters of the next page from the `Link` header of a paginated registry
+// response, e.g. `</v2/busybox/tags/list?last=1.2&n=100>; rel="next"`, or nil on the last page
+func nextPageQuery(link string) url.Values {
+	matches := linkNextRegex.FindStringSubmatch(link)
+	if matches == nil {
+		return nil
+	}
+
+	next, err := url.Parse(matches[1])
+	if err != nil {
+		return nil
+	}
+	return next.Query()
+}
+
+// FetchDockerTags returns the tags of the image, following the `Link` header of the registry API when the list is
+// paginated
+func FetchDockerTags(c *resty.Client, repo, image string) ([]string, error) {
+	tags := []string{}
+	query := url.Values{}
+	for {
+		tagsList := DockerTagsList{}
+		resp, err := c.R().
+			SetQueryParamsFromValues(query).
+			SetResult(&tagsList).
+			Get(fmt.Sprintf(DockerV2Endpoint+"/tags/list", repo, image))
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, tagsList.Tags...)
+
+		next := nextPageQuery(resp.Header().Get("Link"))
+		if next == nil || next.Encode() == query.Encode() {
+			return tags, nil
+		}
+		query = next
+	}
+}
+
+func filterTags(tags []string, filter *regexp.Regexp) []string {
+	filtered := []string{}
+	for _, tag := range tags {
+		if filter.MatchString(tag) {
+			filtered = append(filtered, tag)
+		}
+	}
+	return filtered
+}
+
+// semver parsed form of a `MAJOR.MINOR.PATCH[-PRERELEASE][+BUILD]` tag, with an optional `v` prefix
+type semver struct {
+	numbers    []int
+	prerelease string
+}
+
+var semverRegex = regexp.MustCompile(`^v?(\d+)(?:\.(\d+))?(?:\.(\d+))?(?:-([0-9A-Za-z.-]+))?(?:\+[0-9A-Za-z.-]+)?$`)
+
+func parseSemver(tag string) (semver, bool) {
+	matches := semverRegex.FindStringSubmatch(tag)
+	if matches == nil {
+		return semver{}, false
+	}
+
+	version := semver{prerelease: matches[4]}
+	for _, number := range matches[1:4] {
+		n, _ := strconv.Atoi(number) // missing minor or patch are 0
+		version.numbers = append(version.numbers, n)
+	}
+	return version, true
+}
+
+// IsSemver returns true when the tag is a semantic version, with an optional `v` prefix
+func IsSemver(tag string) bool {
+	return semverRegex.MatchString(tag)
+}
+
+func (v semver) less(other semver) bool {
+	for i := range v.numbers {
+		if v.numbers[i] != other.numbers[i] {
+			return v.numbers[i] < other.numbers[i]
+		}
+	}
+
+	// a pre-release version has lower precedence than the associated normal version
+	switch {
+	case v.prerelease == other.prerelease:
+		return false
+	case v.prerelease == "":
+		return false
+	case other.prerelease == "":
+		return true
+	default:
+		return comparePrerelease(v.prerelease, other.prerelease) < 0
+	}
+}
+
+var numericIdentifierRegex = regexp.MustCompile(`^\d+$`)
+
+// comparePrerelease compares two pre-release versions identifier by identifier, see https://semver.org/#spec-item-11:
+// numeric identifiers are compared numerically and have lower precedence than alphanumeric identifiers, which are
+// compared lexically, and a larger set of identifiers has a higher precedence when all the preceding ones are equal.
+func comparePrerelease(a, b string) int {
+	identifiersA, identifiersB := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(identifiersA) && i < len(identifiersB); i++ {
+		x, y := identifiersA[i], identifiersB[i]
+		xNumeric, yNumeric := numericIdentifierRegex.MatchString(x), numericIdentifierRegex.MatchString(y)
+
+		switch {
+		case xNumeric && yNumeric:
+			// compared by length first, numeric identifiers may not fit in an int
+			x, y = strings.TrimLeft(x, "0"), strings.TrimLeft(y, "0")
+			if len(x) != len(y) {
+				return len(x) - len(y)
+			}
+			if c := strings.Compare(x, y); c != 0 {
+				return c
+			}
+		case xNumeric:
+			return -1
+		case yNumeric:
+			return 1
+		default:
+			if c := strings.Compare(x, y); c != 0 {
+				return c
+			}
+		}
+	}
+
+	return len(identifiersA) - len(identifiersB)
+}
+
+// SortTagsBySemver sorts the tags by semantic version in ascending order. Tags which are not semantic versions
+// are sorted lexically and placed before the semantic versions.
+func SortTagsBySemver(tags []string) {
+	sort.SliceStable(tags, func(i, j int) bool {
+		vi, iOk := parseSemver(tags[i])
+		vj, jOk := parseSemver(tags[j])
+
+		switch {
+		case !iOk && !jOk:
+			return strings.Compare(tags[i], tags[j]) < 0
+		case !iOk:
+			return true
+		case !jOk:
+			return false
+		default:
+			return vi.less(vj)
+		}
+	})
+}
@@ -0,0 +1,103 @@
+package datasource_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/acctest"
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/artifactory/datasource"
+	"github.com/jfrog/terraform-provider-shared/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortTagsBySemver(t *testing.T) {
+	tags := []string{"1.10.0", "latest", "v1.2.0", "1.2.0-rc.1", "1.9", "2.0.0", "1.2.0+build.5", "edge"}
+
+	datasource.SortTagsBySemver(tags)
+
+	assert.Equal(t, []string{"edge", "latest", "1.2.0-rc.1", "v1.2.0", "1.2.0+build.5", "1.9", "1.10.0", "2.0.0"}, tags)
+}
+
+func TestSortTagsBySemver_prerelease(t *testing.T) {
+	// precedence example of https://semver.org/#spec-item-11, with numeric identifiers larger than 9
+	tags := []string{"1.0.0", "1.0.0-rc.10", "1.0.0-beta.11", "1.0.0-alpha.beta", "1.0.0-rc.2", "1.0.0-beta", "1.0.0-alpha", "1.0.0-beta.2", "1.0.0-alpha.1", "1.0.0-rc.1"}
+
+	datasource.SortTagsBySemver(tags)
+
+	assert.Equal(t, []string{"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta", "1.0.0-beta.2", "1.0.0-beta.11", "1.0.0-rc.1", "1.0.0-rc.2", "1.0.0-rc.10", "1.0.0"}, tags)
+}
+
+func TestAccDataSourceDockerTags_full(t *testing.T) {
+	_, _, name := test.MkNames("docker-local", "artifactory_local_docker_v2_repository")
+	const image = "my-org/app"
+
+	config := fmt.Sprintf(`
+		data "artifactory_docker_tags" "all" {
+			repository = "%s"
+			image      = "%s"
+		}
+
+		data "artifactory_docker_tags" "semver" {
+			repository     = "%s"
+			image          = "%s"
+			filter         = "^1\\."
+			sort_by_semver = true
+		}
+	`, name, image, name, image)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.CreateRepo(t, name, "local", "docker", false, false)
+			acctest.PushDockerImage(t, name, image, "latest", "1.0.0", "1.0.0-rc.2", "1.0.0-rc.10")
+		},
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			acctest.DeleteRepo(t, name)
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.artifactory_docker_tags.all", "tags.#", "4"),
+					resource.TestCheckTypeSetElemAttr("data.artifactory_docker_tags.all", "tags.*", "latest"),
+					resource.TestCheckResourceAttr("data.artifactory_docker_tags.semver", "tags.#", "3"),
+					resource.TestCheckResourceAttr("data.artifactory_docker_tags.semver", "tags.0", "1.0.0-rc.2"),
+					resource.TestCheckResourceAttr("data.artifactory_docker_tags.semver", "tags.1", "1.0.0-rc.10"),
+					resource.TestCheckResourceAttr("data.artifactory_docker_tags.semver", "tags.2", "1.0.0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceDockerTags_imageNotFound(t *testing.T) {
+	_, fqrn, name := test.MkNames("docker-local", "artifactory_local_docker_v2_repository")
+
+	config := fmt.Sprintf(`
+		resource "artifactory_local_docker_v2_repository" "%s" {
+			key = "%s"
+		}
+
+		data "artifactory_docker_tags" "tags" {
+			repository = artifactory_local_docker_v2_repository.%s.key
+			image      = "non-existent/image"
+		}
+	`, name, name, name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      acctest.VerifyDeleted(fqrn, acctest.CheckRepo),
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(".*404.*"),
+			},
+		},
+	})
+}
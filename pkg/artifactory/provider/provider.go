@@ -31,6 +31,8 @@ var productId = "terraform-provider-artifactory/" + Version
 func Provider() *schema.Provider {
 	resourceMap := map[string]*schema.Resource{
 		"artifactory_keypair":                             security.ResourceArtifactoryKeyPair(),
+		"artifactory_signing_key_binding":                 security.ResourceArtifactorySigningKeyBinding(),
+		"artifactory_default_signing_key":                 security.ResourceArtifactoryDefaultSigningKey(),
 		"artifactory_local_nuget_repository":              local.ResourceArtifactoryLocalNugetRepository(),
 		"artifactory_local_maven_repository":              local.ResourceArtifactoryLocalJavaRepository("maven", false),
 		"artifactory_local_alpine_repository":             local.ResourceArtifactoryLocalAlpineRepository(),
@@ -38,6 +40,8 @@ func Provider() *schema.Provider {
 		"artifactory_local_debian_repository":             local.ResourceArtifactoryLocalDebianRepository(),
 		"artifactory_local_docker_v2_repository":          local.ResourceArtifactoryLocalDockerV2Repository(),
 		"artifactory_local_docker_v1_repository":          local.ResourceArtifactoryLocalDockerV1Repository(),
+		"artifactory_docker_retention_policy":             local.ResourceArtifactoryDockerRetentionPolicy(),
+		"artifactory_local_oci_repository":                local.ResourceArtifactoryLocalOciRepository(),
 		"artifactory_local_rpm_repository":                local.ResourceArtifactoryLocalRpmRepository(),
 		"artifactory_local_terraform_module_repository":   local.ResourceArtifactoryLocalTerraformRepository("module"),
 		"artifactory_local_terraform_provider_repository": local.ResourceArtifactoryLocalTerraformRepository("provider"),
@@ -46,6 +50,7 @@ func Provider() *schema.Provider {
 		"artifactory_remote_cocoapods_repository":         remote.ResourceArtifactoryRemoteCocoapodsRepository(),
 		"artifactory_remote_composer_repository":          remote.ResourceArtifactoryRemoteComposerRepository(),
 		"artifactory_remote_docker_repository":            remote.ResourceArtifactoryRemoteDockerRepository(),
+		"artifactory_remote_oci_repository":               remote.ResourceArtifactoryRemoteOciRepository(),
 		"artifactory_remote_go_repository":                remote.ResourceArtifactoryRemoteGoRepository(),
 		"artifactory_remote_helm_repository":              remote.ResourceArtifactoryRemoteHelmRepository(),
 		"artifactory_remote_maven_repository":             remote.ResourceArtifactoryRemoteMavenRepository(),
@@ -67,7 +72,12 @@ func Provider() *schema.Provider {
 		"artifactory_unmanaged_user":                      user.ResourceArtifactoryUser(), // alias of artifactory_user
 		"artifactory_managed_user":                        user.ResourceArtifactoryManagedUser(),
 		"artifactory_anonymous_user":                      user.ResourceArtifactoryAnonymousUser(),
+		"artifactory_group_members":                       user.ResourceArtifactoryGroupMembers(),
+		"artifactory_user_group_membership":               user.ResourceArtifactoryUserGroupMembership(),
+		"artifactory_users_bulk":                          user.ResourceArtifactoryUsersBulk(),
+		"artifactory_inactive_users_policy":               user.ResourceArtifactoryInactiveUsersPolicy(),
 		"artifactory_permission_target":                   security.ResourceArtifactoryPermissionTarget(),
+		"artifactory_permission_target_grant":             security.ResourceArtifactoryPermissionTargetGrant(),
 		"artifactory_pull_replication":                    replication.ResourceArtifactoryPullReplication(),
 		"artifactory_push_replication":                    replication.ResourceArtifactoryPushReplication(),
 		"artifactory_certificate":                         security.ResourceArtifactoryCertificate(),
@@ -75,6 +85,11 @@ func Provider() *schema.Provider {
 		"artifactory_access_token":                        security.ResourceArtifactoryAccessToken(),
 		"artifactory_scoped_token":                        security.ResourceArtifactoryScopedToken(),
 		"artifactory_general_security":                    configuration.ResourceArtifactoryGeneralSecurity(),
+		"artifactory_security_policy":                     configuration.ResourceArtifactorySecurityPolicy(),
+		"artifactory_ssh_server_settings":                 configuration.ResourceArtifactorySshServerSettings(),
+		"artifactory_proxy":                               configuration.ResourceArtifactoryProxy(),
+		"artifactory_mail_server":                         configuration.ResourceArtifactoryMailServer(),
+		"artifactory_property_set":                        configuration.ResourceArtifactoryPropertySet(),
 		"artifactory_oauth_settings":                      configuration.ResourceArtifactoryOauthSettings(),
 		"artifactory_saml_settings":                       configuration.ResourceArtifactorySamlSettings(),
 		"artifactory_permission_targets":                  security.ResourceArtifactoryPermissionTargets(), // Deprecated. Remove in V7
@@ -164,8 +179,16 @@ func Provider() *schema.Provider {
 		DataSourcesMap: util.AddTelemetry(
 			productId,
 			map[string]*schema.Resource{
-				"artifactory_file":     datasource.ArtifactoryFile(),
-				"artifactory_fileinfo": datasource.ArtifactoryFileInfo(),
+				"artifactory_file":                  datasource.ArtifactoryFile(),
+				"artifactory_fileinfo":              datasource.ArtifactoryFileInfo(),
+				"artifactory_docker_tags":           datasource.ArtifactoryDockerTags(),
+				"artifactory_docker_image":          datasource.ArtifactoryDockerImage(),
+				"artifactory_permission_target":     security.DataSourceArtifactoryPermissionTarget(),
+				"artifactory_effective_permissions": security.DataSourceArtifactoryEffectivePermissions(),
+				"artifactory_group":                 security.DataSourceArtifactoryGroup(),
+				"artifactory_certificates":          security.DataSourceArtifactoryCertificates(),
+				"artifactory_user":                  user.DataSourceArtifactoryUser(),
+				"artifactory_users":                 user.DataSourceArtifactoryUsers(),
 			},
 		),
 	}
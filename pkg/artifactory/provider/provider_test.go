@@ -1,9 +1,11 @@
 package provider_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/artifactory/provider"
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/artifactory/resource/repository"
 )
 
 func TestProvider(t *testing.T) {
@@ -15,3 +17,25 @@ func TestProvider(t *testing.T) {
 func TestProvider_impl(t *testing.T) {
 	var _ = provider.Provider()
 }
+
+// the attributes reset to their default value when removed from the configuration must be Optional & Computed, the
+// other attributes are already planned with their zero value
+func TestProvider_RepoDefaultValues(t *testing.T) {
+	for name, resource := range provider.Provider().ResourcesMap {
+		parts := strings.Split(name, "_")
+		if len(parts) != 4 || parts[0] != "artifactory" || parts[3] != "repository" {
+			continue
+		}
+
+		for key := range repository.GetDefaultRepoValues(parts[1], parts[2]) {
+			attribute, ok := resource.Schema[key]
+			if !ok {
+				t.Errorf("%s: default value for unknown attribute %s", name, key)
+				continue
+			}
+			if !attribute.Optional || !attribute.Computed {
+				t.Errorf("%s: default value for attribute %s, which is not Optional & Computed", name, key)
+			}
+		}
+	}
+}
@@ -17,3 +17,15 @@ func SendConfigurationPatch(content []byte, m interface{}) error {
 
 	return err
 }
+
+/* SendAccessConfigurationPatch updates the Access configuration using YAML data.
+
+See https://www.jfrog.com/confluence/display/JFROG/Access+YAML+Configuration
+*/
+func SendAccessConfigurationPatch(content []byte, m interface{}) error {
+	_, err := m.(*resty.Client).R().SetBody(content).
+		SetHeader("Content-Type", "application/yaml").
+		Patch("access/api/v1/config")
+
+	return err
+}
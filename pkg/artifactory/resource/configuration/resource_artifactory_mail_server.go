@@ -0,0 +1,187 @@
+package configuration
+
+import (
+	"context"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"gopkg.in/yaml.v3"
+)
+
+type MailServer struct {
+	Enabled        bool   `xml:"enabled" yaml:"enabled"`
+	Host           string `xml:"host" yaml:"host"`
+	Port           int    `xml:"port" yaml:"port"`
+	Username       string `xml:"username" yaml:"username"`
+	Password       string `xml:"-" yaml:"password"`
+	From           string `xml:"from" yaml:"from"`
+	SubjectPrefix  string `xml:"subjectPrefix" yaml:"subjectPrefix"`
+	UseTls         bool   `xml:"tls" yaml:"tls"`
+	UseSsl         bool   `xml:"ssl" yaml:"ssl"`
+	ArtifactoryUrl string `xml:"artifactoryUrl" yaml:"artifactoryUrl"`
+}
+
+type MailServerConfig struct {
+	MailServer *MailServer `xml:"mailServer" yaml:"mailServer"`
+}
+
+func ResourceArtifactoryMailServer() *schema.Resource {
+	var unpackMailServer = func(s *schema.ResourceData) *MailServerConfig {
+		d := &util.ResourceData{ResourceData: s}
+		return &MailServerConfig{
+			MailServer: &MailServer{
+				Enabled:        d.GetBool("enabled", false),
+				Host:           d.GetString("host", false),
+				Port:           d.GetInt("port", false),
+				Username:       d.GetString("username", false),
+				Password:       d.GetString("password", false),
+				From:           d.GetString("from", false),
+				SubjectPrefix:  d.GetString("subject_prefix", false),
+				UseTls:         d.GetBool("use_tls", false),
+				UseSsl:         d.GetBool("use_ssl", false),
+				ArtifactoryUrl: d.GetString("artifactory_url", false),
+			},
+		}
+	}
+
+	var packMailServer = func(mailServer *MailServer, d *schema.ResourceData) diag.Diagnostics {
+		setValue := util.MkLens(d)
+
+		setValue("enabled", mailServer.Enabled)
+		setValue("host", mailServer.Host)
+		setValue("port", mailServer.Port)
+		setValue("username", mailServer.Username)
+		setValue("from", mailServer.From)
+		setValue("subject_prefix", mailServer.SubjectPrefix)
+		setValue("use_tls", mailServer.UseTls)
+		setValue("use_ssl", mailServer.UseSsl)
+		errors := setValue("artifactory_url", mailServer.ArtifactoryUrl)
+
+		if errors != nil && len(errors) > 0 {
+			return diag.Errorf("failed to pack mail server %q", errors)
+		}
+
+		return nil
+	}
+
+	var resourceMailServerRead = func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		config := &MailServerConfig{}
+
+		_, err := m.(*resty.Client).R().SetResult(config).Get("artifactory/api/system/configuration")
+		if err != nil {
+			return diag.Errorf("failed to retrieve data from API: /artifactory/api/system/configuration during Read")
+		}
+
+		if config.MailServer == nil {
+			d.SetId("")
+			return nil
+		}
+
+		// the password isn't returned by the API
+		return packMailServer(config.MailServer, d)
+	}
+
+	var resourceMailServerUpdate = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		content, err := yaml.Marshal(unpackMailServer(d))
+		if err != nil {
+			return diag.Errorf("failed to marshal mail server during Update")
+		}
+
+		err = SendConfigurationPatch(content, m)
+		if err != nil {
+			return diag.Errorf("failed to send PATCH request to Artifactory during Update")
+		}
+
+		// we should only have one mail server resource, using same id
+		d.SetId("mail_server")
+		return resourceMailServerRead(ctx, d, m)
+	}
+
+	var resourceMailServerDelete = func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		err := SendConfigurationPatch([]byte("mailServer: ~\n"), m)
+		if err != nil {
+			return diag.Errorf("failed to send PATCH request to Artifactory during Delete")
+		}
+
+		d.SetId("")
+		return nil
+	}
+
+	return &schema.Resource{
+		UpdateContext: resourceMailServerUpdate,
+		CreateContext: resourceMailServerUpdate,
+		DeleteContext: resourceMailServerDelete,
+		ReadContext:   resourceMailServerRead,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "When set, mail notifications are enabled. Default value is 'true'.",
+			},
+			"host": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+				Description:      "Host name of the mail server.",
+			},
+			"port": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          25,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsPortNumber),
+				Description:      "Port of the mail server. Default value is 25.",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Username to authenticate with the mail server.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password to authenticate with the mail server. Not returned by the API, changes made outside of Terraform aren't detected.",
+			},
+			"from": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Sender address of the emails, e.g. 'artifactory@example.com'.",
+			},
+			"subject_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "[Artifactory]",
+				Description: "Prefix of the subject of the emails. Default value is '[Artifactory]'.",
+			},
+			"use_tls": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Use TLS (STARTTLS) to connect to the mail server. Default value is 'false'.",
+			},
+			"use_ssl": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Use SSL to connect to the mail server. Default value is 'false'.",
+			},
+			"artifactory_url": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.Any(validation.StringIsEmpty, validation.IsURLWithHTTPorHTTPS)),
+				Description:      "Artifactory URL used in the links of the emails.",
+			},
+		},
+
+		Description: "Manages the mail server Artifactory uses to send notifications, e.g. backup errors and password expiration.",
+	}
+}
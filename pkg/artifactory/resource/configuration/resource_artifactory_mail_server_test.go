@@ -0,0 +1,82 @@
+package configuration_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/acctest"
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/artifactory/resource/configuration"
+)
+
+const MailServerTemplateFull = `
+resource "artifactory_mail_server" "mail" {
+	enabled         = true
+	host            = "smtp.example.com"
+	port            = 587
+	username        = "mailer"
+	password        = "Passw0rd!"
+	from            = "artifactory@example.com"
+	subject_prefix  = "[Test]"
+	use_tls         = true
+	use_ssl         = false
+	artifactory_url = "https://artifactory.example.com"
+}`
+
+func TestAccMailServer_full(t *testing.T) {
+	fqrn := "artifactory_mail_server.mail"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccMailServerDestroy(fqrn),
+
+		Steps: []resource.TestStep{
+			{
+				Config: MailServerTemplateFull,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "enabled", "true"),
+					resource.TestCheckResourceAttr(fqrn, "host", "smtp.example.com"),
+					resource.TestCheckResourceAttr(fqrn, "port", "587"),
+					resource.TestCheckResourceAttr(fqrn, "username", "mailer"),
+					resource.TestCheckResourceAttr(fqrn, "from", "artifactory@example.com"),
+					resource.TestCheckResourceAttr(fqrn, "subject_prefix", "[Test]"),
+					resource.TestCheckResourceAttr(fqrn, "use_tls", "true"),
+					resource.TestCheckResourceAttr(fqrn, "use_ssl", "false"),
+					resource.TestCheckResourceAttr(fqrn, "artifactory_url", "https://artifactory.example.com"),
+				),
+			},
+			{
+				ResourceName:            fqrn,
+				ImportState:             true,
+				ImportStateId:           "mail_server",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func testAccMailServerDestroy(id string) func(*terraform.State) error {
+	return func(s *terraform.State) error {
+		client := acctest.Provider.Meta().(*resty.Client)
+
+		_, ok := s.RootModule().Resources[id]
+		if !ok {
+			return fmt.Errorf("error: resource id [%s] not found", id)
+		}
+
+		config := configuration.MailServerConfig{}
+		_, err := client.R().SetResult(&config).Get("artifactory/api/system/configuration")
+		if err != nil {
+			return fmt.Errorf("error: failed to retrieve data from API: /artifactory/api/system/configuration during Read")
+		}
+		if config.MailServer != nil {
+			return fmt.Errorf("error: mail server still exists: %+v", *config.MailServer)
+		}
+
+		return nil
+	}
+}
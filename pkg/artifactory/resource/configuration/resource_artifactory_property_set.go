@@ -0,0 +1,304 @@
+package configuration
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"gopkg.in/yaml.v3"
+)
+
+type PredefinedValue struct {
+	Value        string `xml:"value"`
+	DefaultValue bool   `xml:"defaultValue"`
+}
+
+type Property struct {
+	Name                   string            `xml:"name"`
+	PredefinedValues       []PredefinedValue `xml:"predefinedValues>predefinedValue"`
+	ClosedPredefinedValues bool              `xml:"closedPredefinedValues"`
+	MultipleChoice         bool              `xml:"multipleChoice"`
+}
+
+type PropertySet struct {
+	Name       string     `xml:"name"`
+	Visible    bool       `xml:"visible"`
+	Properties []Property `xml:"properties>property"`
+}
+
+type PropertySets struct {
+	PropertySets []PropertySet `xml:"propertySets>propertySet"`
+}
+
+// GetPropertySets returns the property sets of the Artifactory configuration, including the built-in ones
+func GetPropertySets(m interface{}) ([]PropertySet, error) {
+	propertySets := &PropertySets{}
+	_, err := m.(*resty.Client).R().SetResult(propertySets).Get("artifactory/api/system/configuration")
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve data from API: /artifactory/api/system/configuration: %s", err)
+	}
+	return propertySets.PropertySets, nil
+}
+
+// FindPropertySet returns the property set with the name from the Artifactory configuration, or nil if there is no
+// such property set
+func FindPropertySet(name string, m interface{}) (*PropertySet, error) {
+	propertySets, err := GetPropertySets(m)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, propertySet := range propertySets {
+		if propertySet.Name == name {
+			return &propertySet, nil
+		}
+	}
+	return nil, nil
+}
+
+func ResourceArtifactoryPropertySet() *schema.Resource {
+	var unpackProperties = func(set *schema.Set) map[string]Property {
+		properties := map[string]Property{}
+		for _, raw := range set.List() {
+			p := raw.(map[string]interface{})
+			property := Property{
+				Name:                   p["name"].(string),
+				ClosedPredefinedValues: p["closed_predefined_values"].(bool),
+				MultipleChoice:         p["multiple_choice"].(bool),
+			}
+			for _, rawValue := range p["predefined_value"].(*schema.Set).List() {
+				v := rawValue.(map[string]interface{})
+				property.PredefinedValues = append(property.PredefinedValues, PredefinedValue{
+					Value:        v["name"].(string),
+					DefaultValue: v["default_value"].(bool),
+				})
+			}
+			properties[property.Name] = property
+		}
+		return properties
+	}
+
+	var packProperties = func(properties []Property) []interface{} {
+		packed := make([]interface{}, 0, len(properties))
+		for _, property := range properties {
+			values := make([]interface{}, 0, len(property.PredefinedValues))
+			for _, value := range property.PredefinedValues {
+				values = append(values, map[string]interface{}{
+					"name":          value.Value,
+					"default_value": value.DefaultValue,
+				})
+			}
+			packed = append(packed, map[string]interface{}{
+				"name":                     property.Name,
+				"predefined_value":         values,
+				"closed_predefined_values": property.ClosedPredefinedValues,
+				"multiple_choice":          property.MultipleChoice,
+			})
+		}
+		return packed
+	}
+
+	// mkPropertySetBody builds the PATCH body of the property set. The PATCH call expects the properties and their
+	// predefined values by name, see the explanation in resourceBackupUpdate, and merges them with the current ones:
+	// the properties and values removed since the previous apply are explicitly deleted.
+	var mkPropertySetBody = func(d *schema.ResourceData) map[string]interface{} {
+		oldSet, newSet := d.GetChange("property")
+		current := unpackProperties(oldSet.(*schema.Set))
+		desired := unpackProperties(newSet.(*schema.Set))
+
+		properties := map[string]interface{}{}
+		for name := range current {
+			if _, ok := desired[name]; !ok {
+				properties[name] = nil
+			}
+		}
+		for name, property := range desired {
+			values := map[string]interface{}{}
+			if existing, ok := current[name]; ok {
+				for _, value := range existing.PredefinedValues {
+					values[value.Value] = nil
+				}
+			}
+			for _, value := range property.PredefinedValues {
+				values[value.Value] = map[string]interface{}{
+					"defaultValue": value.DefaultValue,
+				}
+			}
+			properties[name] = map[string]interface{}{
+				"closedPredefinedValues": property.ClosedPredefinedValues,
+				"multipleChoice":         property.MultipleChoice,
+				"predefinedValues":       values,
+			}
+		}
+
+		return map[string]interface{}{
+			"propertySets": map[string]interface{}{
+				d.Get("name").(string): map[string]interface{}{
+					"visible":    d.Get("visible").(bool),
+					"properties": properties,
+				},
+			},
+		}
+	}
+
+	var resourcePropertySetRead = func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		propertySet, err := FindPropertySet(d.Id(), m)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if propertySet == nil {
+			d.SetId("")
+			return nil
+		}
+
+		setValue := util.MkLens(d)
+		setValue("name", propertySet.Name)
+		setValue("visible", propertySet.Visible)
+		errors := setValue("property", packProperties(propertySet.Properties))
+		if errors != nil && len(errors) > 0 {
+			return diag.Errorf("failed to pack property set %q", errors)
+		}
+
+		return nil
+	}
+
+	var resourcePropertySetUpdate = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		content, err := yaml.Marshal(mkPropertySetBody(d))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		err = SendConfigurationPatch(content, m)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId(d.Get("name").(string))
+		return resourcePropertySetRead(ctx, d, m)
+	}
+
+	var resourcePropertySetDelete = func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		deletePropertySetConfig := fmt.Sprintf(`
+propertySets:
+  %s: ~
+`, d.Id())
+
+		err := SendConfigurationPatch([]byte(deletePropertySetConfig), m)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId("")
+		return nil
+	}
+
+	var propertySetDiff = func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+		properties := diff.Get("property").(*schema.Set).List()
+		for _, raw := range properties {
+			p := raw.(map[string]interface{})
+			if p["multiple_choice"].(bool) && !p["closed_predefined_values"].(bool) {
+				return fmt.Errorf("property %s: multiple_choice requires closed_predefined_values", p["name"])
+			}
+
+			var defaults []string
+			for _, rawValue := range p["predefined_value"].(*schema.Set).List() {
+				v := rawValue.(map[string]interface{})
+				if v["default_value"].(bool) {
+					defaults = append(defaults, v["name"].(string))
+				}
+			}
+			if len(defaults) > 1 && !p["multiple_choice"].(bool) {
+				sort.Strings(defaults)
+				return fmt.Errorf("property %s: only one default value is allowed without multiple_choice, got %q", p["name"], defaults)
+			}
+		}
+		return nil
+	}
+
+	return &schema.Resource{
+		UpdateContext: resourcePropertySetUpdate,
+		CreateContext: resourcePropertySetUpdate,
+		DeleteContext: resourcePropertySetDelete,
+		ReadContext:   resourcePropertySetRead,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: propertySetDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.All(
+					validation.StringIsNotEmpty,
+					validation.StringDoesNotContainAny(" "),
+				)),
+				Description: "Name of the property set, referenced by the `property_sets` attribute of the local and remote repositories.",
+			},
+			"visible": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Show the property set when adding properties to artifacts in the UI. Default value is 'true'.",
+			},
+			"property": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+							Description:      "Name of the property.",
+						},
+						"predefined_value": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+										Description:      "Predefined value.",
+									},
+									"default_value": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Default:     false,
+										Description: "Select the value by default. Default value is 'false'.",
+									},
+								},
+							},
+							Description: "Predefined values of the property.",
+						},
+						"closed_predefined_values": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Only allow the predefined values. Default value is 'false'.",
+						},
+						"multiple_choice": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Allow several predefined values to be selected, requires `closed_predefined_values`. Default value is 'false'.",
+						},
+					},
+				},
+				Description: "Properties of the property set.",
+			},
+		},
+
+		Description: "Provides an Artifactory property set resource, referenced by the `property_sets` attribute of the local and remote repositories.",
+	}
+}
@@ -0,0 +1,150 @@
+package configuration_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/acctest"
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/artifactory/resource/configuration"
+	"github.com/jfrog/terraform-provider-shared/test"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccPropertySet_full(t *testing.T) {
+	_, fqrn, name := test.MkNames("property-set-test", "artifactory_property_set")
+
+	mkConfig := func(visible bool, extraValue string) string {
+		return util.ExecuteTemplate("TestAccPropertySet", `
+			resource "artifactory_property_set" "{{ .name }}" {
+				name    = "{{ .name }}"
+				visible = {{ .visible }}
+
+				property {
+					name                     = "environment"
+					closed_predefined_values = true
+
+					predefined_value {
+						name          = "dev"
+						default_value = true
+					}
+
+					predefined_value {
+						name = "{{ .extraValue }}"
+					}
+				}
+
+				property {
+					name                     = "teams"
+					closed_predefined_values = true
+					multiple_choice          = true
+
+					predefined_value {
+						name = "platform"
+					}
+				}
+			}
+
+			resource "artifactory_local_generic_repository" "{{ .name }}" {
+				key           = "{{ .name }}-local"
+				property_sets = [artifactory_property_set.{{ .name }}.id]
+			}
+		`, map[string]interface{}{
+			"name":       name,
+			"visible":    visible,
+			"extraValue": extraValue,
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccPropertySetDestroy(name),
+
+		Steps: []resource.TestStep{
+			{
+				Config: mkConfig(true, "prod"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "name", name),
+					resource.TestCheckResourceAttr(fqrn, "visible", "true"),
+					resource.TestCheckResourceAttr(fqrn, "property.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(fqrn, "property.*", map[string]string{
+						"name":                     "environment",
+						"closed_predefined_values": "true",
+						"multiple_choice":          "false",
+						"predefined_value.#":       "2",
+					}),
+					resource.TestCheckResourceAttr(fmt.Sprintf("artifactory_local_generic_repository.%s", name), "property_sets.#", "1"),
+				),
+			},
+			{
+				Config: mkConfig(false, "staging"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "visible", "false"),
+					resource.TestCheckTypeSetElemNestedAttrs(fqrn, "property.*", map[string]string{
+						"name":               "environment",
+						"predefined_value.#": "2",
+					}),
+				),
+			},
+			{
+				ResourceName:      fqrn,
+				ImportState:       true,
+				ImportStateId:     name,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPropertySet_invalidDefaultValues(t *testing.T) {
+	_, _, name := test.MkNames("property-set-test", "artifactory_property_set")
+
+	config := fmt.Sprintf(`
+		resource "artifactory_property_set" "%s" {
+			name = "%s"
+
+			property {
+				name                     = "environment"
+				closed_predefined_values = true
+
+				predefined_value {
+					name          = "dev"
+					default_value = true
+				}
+
+				predefined_value {
+					name          = "prod"
+					default_value = true
+				}
+			}
+		}
+	`, name, name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(".*only one default value is allowed without multiple_choice.*"),
+			},
+		},
+	})
+}
+
+func testAccPropertySetDestroy(name string) func(*terraform.State) error {
+	return func(_ *terraform.State) error {
+		propertySet, err := configuration.FindPropertySet(name, acctest.Provider.Meta())
+		if err != nil {
+			return err
+		}
+		if propertySet != nil {
+			return fmt.Errorf("error: property set %s still exists", name)
+		}
+		return nil
+	}
+}
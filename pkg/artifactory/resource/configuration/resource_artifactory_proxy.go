@@ -0,0 +1,231 @@
+package configuration
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"gopkg.in/yaml.v3"
+)
+
+type Proxy struct {
+	Key               string `xml:"key" yaml:"key"`
+	Host              string `xml:"host" yaml:"host"`
+	Port              int    `xml:"port" yaml:"port"`
+	Username          string `xml:"username" yaml:"username"`
+	Password          string `xml:"-" yaml:"password"`
+	NtHost            string `xml:"ntHost" yaml:"ntHost"`
+	NtDomain          string `xml:"domain" yaml:"domain"`
+	PlatformDefault   bool   `xml:"defaultProxy" yaml:"defaultProxy"`
+	RedirectedToHosts string `xml:"redirectedToHosts" yaml:"redirectedToHosts"`
+	Services          string `xml:"services" yaml:"services"`
+}
+
+type Proxies struct {
+	Proxies []Proxy `xml:"proxies>proxy" yaml:"proxy"`
+}
+
+// FindProxy returns the proxy with the key from the Artifactory configuration, or nil if there is no such proxy
+func FindProxy(key string, m interface{}) (*Proxy, error) {
+	proxies := &Proxies{}
+	_, err := m.(*resty.Client).R().SetResult(proxies).Get("artifactory/api/system/configuration")
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve data from API: /artifactory/api/system/configuration: %s", err)
+	}
+
+	for _, proxy := range proxies.Proxies {
+		if proxy.Key == key {
+			return &proxy, nil
+		}
+	}
+	return nil, nil
+}
+
+// splitCommaSeparated splits the comma separated lists of the proxy configuration
+func splitCommaSeparated(value string) []string {
+	values := []string{}
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	sort.Strings(values)
+	return values
+}
+
+func ResourceArtifactoryProxy() *schema.Resource {
+	var unpackProxy = func(s *schema.ResourceData) Proxy {
+		d := &util.ResourceData{ResourceData: s}
+		redirectedToHosts := d.GetSet("redirect_to_hosts")
+		services := d.GetSet("services")
+		sort.Strings(redirectedToHosts)
+		sort.Strings(services)
+
+		return Proxy{
+			Key:               d.GetString("key", false),
+			Host:              d.GetString("host", false),
+			Port:              d.GetInt("port", false),
+			Username:          d.GetString("username", false),
+			Password:          d.GetString("password", false),
+			NtHost:            d.GetString("nt_host", false),
+			NtDomain:          d.GetString("nt_domain", false),
+			PlatformDefault:   d.GetBool("platform_default", false),
+			RedirectedToHosts: strings.Join(redirectedToHosts, ","),
+			Services:          strings.Join(services, ","),
+		}
+	}
+
+	var packProxy = func(proxy *Proxy, d *schema.ResourceData) diag.Diagnostics {
+		setValue := util.MkLens(d)
+
+		setValue("key", proxy.Key)
+		setValue("host", proxy.Host)
+		setValue("port", proxy.Port)
+		setValue("username", proxy.Username)
+		setValue("nt_host", proxy.NtHost)
+		setValue("nt_domain", proxy.NtDomain)
+		setValue("platform_default", proxy.PlatformDefault)
+		setValue("redirect_to_hosts", splitCommaSeparated(proxy.RedirectedToHosts))
+		errors := setValue("services", splitCommaSeparated(proxy.Services))
+
+		if errors != nil && len(errors) > 0 {
+			return diag.Errorf("failed to pack proxy %q", errors)
+		}
+
+		return nil
+	}
+
+	var resourceProxyRead = func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		proxy, err := FindProxy(d.Id(), m)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if proxy == nil {
+			d.SetId("")
+			return nil
+		}
+
+		// the password isn't returned by the API
+		return packProxy(proxy, d)
+	}
+
+	var resourceProxyUpdate = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		unpackedProxy := unpackProxy(d)
+
+		// the PATCH call expects the proxies by key, see the explanation in resourceBackupUpdate
+		constructBody := map[string]map[string]Proxy{
+			"proxies": {
+				unpackedProxy.Key: unpackedProxy,
+			},
+		}
+		content, err := yaml.Marshal(&constructBody)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		err = SendConfigurationPatch(content, m)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId(unpackedProxy.Key)
+		return resourceProxyRead(ctx, d, m)
+	}
+
+	var resourceProxyDelete = func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		deleteProxyConfig := fmt.Sprintf(`
+proxies:
+  %s: ~
+`, d.Id())
+
+		err := SendConfigurationPatch([]byte(deleteProxyConfig), m)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId("")
+		return nil
+	}
+
+	return &schema.Resource{
+		UpdateContext: resourceProxyUpdate,
+		CreateContext: resourceProxyUpdate,
+		DeleteContext: resourceProxyDelete,
+		ReadContext:   resourceProxyRead,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+				Description:      "Key of the proxy, referenced by the `proxy` attribute of the remote repositories, replications and webhooks.",
+			},
+			"host": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+				Description:      "Host name or IP address of the proxy.",
+			},
+			"port": {
+				Type:             schema.TypeInt,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsPortNumber),
+				Description:      "Port of the proxy.",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Username to authenticate with the proxy.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password to authenticate with the proxy. Not returned by the API, changes made outside of Terraform aren't detected.",
+			},
+			"nt_host": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Computer name of the machine the proxy requests are sent from, for NTLM authentication.",
+			},
+			"nt_domain": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Domain of the user, for NTLM authentication.",
+			},
+			"platform_default": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Use the proxy by default for the remote repositories, replications and the services in `services`. Only one proxy can be the platform default. Default value is 'false'.",
+			},
+			"redirect_to_hosts": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Optional:    true,
+				Description: "Hosts the proxy credentials are also sent to when a request is redirected to them.",
+			},
+			"services": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Optional:    true,
+				Description: "Service IDs of the JFrog platform the default proxy is used for, e.g. 'jfrt@01abc...' or 'jfxr@01abc...'.",
+			},
+		},
+
+		Description: "Provides an Artifactory proxy resource, referenced by the `proxy` attribute of the remote repositories, replications and webhooks.",
+	}
+}
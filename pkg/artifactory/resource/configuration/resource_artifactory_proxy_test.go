@@ -0,0 +1,88 @@
+package configuration_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/acctest"
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/artifactory/resource/configuration"
+	"github.com/jfrog/terraform-provider-shared/test"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccProxy_full(t *testing.T) {
+	_, fqrn, name := test.MkNames("proxy-test", "artifactory_proxy")
+
+	mkConfig := func(port int) string {
+		return util.ExecuteTemplate("TestAccProxy", `
+			resource "artifactory_proxy" "{{ .name }}" {
+				key               = "{{ .name }}"
+				host              = "proxy.example.com"
+				port              = {{ .port }}
+				username          = "proxy-user"
+				password          = "Passw0rd!"
+				nt_host           = "workstation"
+				nt_domain         = "EXAMPLE"
+				redirect_to_hosts = ["mirror.example.com", "cdn.example.com"]
+			}
+
+			resource "artifactory_remote_generic_repository" "{{ .name }}" {
+				key   = "{{ .name }}-remote"
+				url   = "https://example.com/"
+				proxy = artifactory_proxy.{{ .name }}.key
+			}
+		`, map[string]interface{}{
+			"name": name,
+			"port": port,
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccProxyDestroy(name),
+
+		Steps: []resource.TestStep{
+			{
+				Config: mkConfig(8080),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "key", name),
+					resource.TestCheckResourceAttr(fqrn, "host", "proxy.example.com"),
+					resource.TestCheckResourceAttr(fqrn, "port", "8080"),
+					resource.TestCheckResourceAttr(fqrn, "username", "proxy-user"),
+					resource.TestCheckResourceAttr(fqrn, "nt_host", "workstation"),
+					resource.TestCheckResourceAttr(fqrn, "nt_domain", "EXAMPLE"),
+					resource.TestCheckResourceAttr(fqrn, "platform_default", "false"),
+					resource.TestCheckResourceAttr(fqrn, "redirect_to_hosts.#", "2"),
+					resource.TestCheckResourceAttr(fmt.Sprintf("artifactory_remote_generic_repository.%s", name), "proxy", name),
+				),
+			},
+			{
+				Config: mkConfig(3128),
+				Check:  resource.TestCheckResourceAttr(fqrn, "port", "3128"),
+			},
+			{
+				ResourceName:            fqrn,
+				ImportState:             true,
+				ImportStateId:           name,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func testAccProxyDestroy(key string) func(*terraform.State) error {
+	return func(_ *terraform.State) error {
+		proxy, err := configuration.FindProxy(key, acctest.Provider.Meta())
+		if err != nil {
+			return err
+		}
+		if proxy != nil {
+			return fmt.Errorf("error: proxy %s still exists", key)
+		}
+		return nil
+	}
+}
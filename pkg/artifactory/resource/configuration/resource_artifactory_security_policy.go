@@ -0,0 +1,319 @@
+package configuration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"gopkg.in/yaml.v3"
+)
+
+type SecurityPolicy struct {
+	Settings SecurityPolicySettings `xml:"security" yaml:"security"`
+}
+
+type SecurityPolicySettings struct {
+	HideUnauthorizedResources bool             `xml:"hideUnauthorizedResources" yaml:"hideUnauthorizedResources"`
+	PasswordSettings          PasswordSettings `xml:"passwordSettings" yaml:"passwordSettings"`
+	UserLockPolicy            UserLockPolicy   `xml:"userLockPolicy" yaml:"userLockPolicy"`
+}
+
+type PasswordSettings struct {
+	EncryptionPolicy string                   `xml:"encryptionPolicy" yaml:"encryptionPolicy"`
+	ExpirationPolicy PasswordExpirationPolicy `xml:"expirationPolicy" yaml:"expirationPolicy"`
+}
+
+type PasswordExpirationPolicy struct {
+	Enabled        bool `xml:"enabled" yaml:"enabled"`
+	PasswordMaxAge int  `xml:"passwordMaxAge" yaml:"passwordMaxAge"`
+	NotifyByEmail  bool `xml:"notifyByEmail" yaml:"notifyByEmail"`
+}
+
+type UserLockPolicy struct {
+	Enabled       bool `xml:"enabled" yaml:"enabled"`
+	LoginAttempts int  `xml:"loginAttempts" yaml:"loginAttempts"`
+}
+
+// AccessSecurityPolicy holds the password complexity rules, which are part of the Access configuration instead of the
+// Artifactory one
+type AccessSecurityPolicy struct {
+	Security AccessSecuritySettings `yaml:"security"`
+}
+
+type AccessSecuritySettings struct {
+	PasswordPolicy PasswordPolicy `yaml:"password-policy"`
+}
+
+type PasswordPolicy struct {
+	Length      int `yaml:"length"`
+	Uppercase   int `yaml:"uppercase"`
+	Digit       int `yaml:"digit"`
+	SpecialChar int `yaml:"special-char"`
+}
+
+// default security policy of Artifactory, restored when the resource is destroyed. Only the fields of SecurityPolicy
+// are sent, the other settings of the configuration are left untouched.
+var defaultSecurityPolicy = SecurityPolicy{
+	Settings: SecurityPolicySettings{
+		HideUnauthorizedResources: false,
+		PasswordSettings: PasswordSettings{
+			EncryptionPolicy: "supported",
+			ExpirationPolicy: PasswordExpirationPolicy{
+				Enabled:        false,
+				PasswordMaxAge: 60,
+				NotifyByEmail:  true,
+			},
+		},
+		UserLockPolicy: UserLockPolicy{
+			Enabled:       false,
+			LoginAttempts: 5,
+		},
+	},
+}
+
+// default password policy of Access, restored when the resource is destroyed. Only the fields of PasswordPolicy are
+// sent, the other rules of the password policy are left untouched.
+var defaultPasswordPolicy = AccessSecurityPolicy{
+	Security: AccessSecuritySettings{
+		PasswordPolicy: PasswordPolicy{
+			Length:      8,
+			Uppercase:   1,
+			Digit:       1,
+			SpecialChar: 0,
+		},
+	},
+}
+
+// GetPasswordPolicy returns the password policy of the Access configuration
+func GetPasswordPolicy(m interface{}) (*PasswordPolicy, error) {
+	resp, err := m.(*resty.Client).R().Get("access/api/v1/config")
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve data from API: /access/api/v1/config: %s", err)
+	}
+
+	// the configuration is returned as JSON or YAML depending on the version of Access, both are parsed as YAML
+	policy := &AccessSecurityPolicy{}
+	if err := yaml.Unmarshal(resp.Body(), policy); err != nil {
+		return nil, fmt.Errorf("failed to parse the Access configuration: %s", err)
+	}
+	return &policy.Security.PasswordPolicy, nil
+}
+
+func ResourceArtifactorySecurityPolicy() *schema.Resource {
+	var unpackSecurityPolicy = func(s *schema.ResourceData) *SecurityPolicy {
+		d := &util.ResourceData{ResourceData: s}
+		return &SecurityPolicy{
+			Settings: SecurityPolicySettings{
+				HideUnauthorizedResources: d.GetBool("hide_unauthorized_resources", false),
+				PasswordSettings: PasswordSettings{
+					EncryptionPolicy: d.GetString("encryption_policy", false),
+					ExpirationPolicy: PasswordExpirationPolicy{
+						Enabled:        d.GetBool("password_expiration_enabled", false),
+						PasswordMaxAge: d.GetInt("password_max_age", false),
+						NotifyByEmail:  d.GetBool("password_expiration_notify_by_email", false),
+					},
+				},
+				UserLockPolicy: UserLockPolicy{
+					Enabled:       d.GetBool("user_lock_enabled", false),
+					LoginAttempts: d.GetInt("user_lock_login_attempts", false),
+				},
+			},
+		}
+	}
+
+	var unpackPasswordPolicy = func(s *schema.ResourceData) *AccessSecurityPolicy {
+		d := &util.ResourceData{ResourceData: s}
+		return &AccessSecurityPolicy{
+			Security: AccessSecuritySettings{
+				PasswordPolicy: PasswordPolicy{
+					Length:      d.GetInt("password_min_length", false),
+					Uppercase:   d.GetInt("password_min_uppercase", false),
+					Digit:       d.GetInt("password_min_digits", false),
+					SpecialChar: d.GetInt("password_min_special_chars", false),
+				},
+			},
+		}
+	}
+
+	var packSecurityPolicy = func(policy *SecurityPolicy, passwordPolicy *PasswordPolicy, d *schema.ResourceData) diag.Diagnostics {
+		setValue := util.MkLens(d)
+
+		settings := policy.Settings
+		setValue("hide_unauthorized_resources", settings.HideUnauthorizedResources)
+		setValue("encryption_policy", settings.PasswordSettings.EncryptionPolicy)
+		setValue("password_expiration_enabled", settings.PasswordSettings.ExpirationPolicy.Enabled)
+		setValue("password_max_age", settings.PasswordSettings.ExpirationPolicy.PasswordMaxAge)
+		setValue("password_expiration_notify_by_email", settings.PasswordSettings.ExpirationPolicy.NotifyByEmail)
+		setValue("user_lock_enabled", settings.UserLockPolicy.Enabled)
+		setValue("user_lock_login_attempts", settings.UserLockPolicy.LoginAttempts)
+		setValue("password_min_length", passwordPolicy.Length)
+		setValue("password_min_uppercase", passwordPolicy.Uppercase)
+		setValue("password_min_digits", passwordPolicy.Digit)
+		errors := setValue("password_min_special_chars", passwordPolicy.SpecialChar)
+
+		if errors != nil && len(errors) > 0 {
+			return diag.Errorf("failed to pack security policy %q", errors)
+		}
+
+		return nil
+	}
+
+	var resourceSecurityPolicyRead = func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		policy := &SecurityPolicy{}
+
+		_, err := m.(*resty.Client).R().SetResult(policy).Get("artifactory/api/system/configuration")
+		if err != nil {
+			return diag.Errorf("failed to retrieve data from API: /artifactory/api/system/configuration during Read")
+		}
+
+		passwordPolicy, err := GetPasswordPolicy(m)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		return packSecurityPolicy(policy, passwordPolicy, d)
+	}
+
+	var resourceSecurityPolicyUpdate = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		content, err := yaml.Marshal(unpackSecurityPolicy(d))
+		if err != nil {
+			return diag.Errorf("failed to marshal security policy during Update")
+		}
+
+		err = SendConfigurationPatch(content, m)
+		if err != nil {
+			return diag.Errorf("failed to send PATCH request to Artifactory during Update")
+		}
+
+		content, err = yaml.Marshal(unpackPasswordPolicy(d))
+		if err != nil {
+			return diag.Errorf("failed to marshal password policy during Update")
+		}
+
+		err = SendAccessConfigurationPatch(content, m)
+		if err != nil {
+			return diag.Errorf("failed to send PATCH request to Access during Update")
+		}
+
+		// we should only have one security policy resource, using same id
+		d.SetId("security_policy")
+		return resourceSecurityPolicyRead(ctx, d, m)
+	}
+
+	var resourceSecurityPolicyDelete = func(_ context.Context, _ *schema.ResourceData, m interface{}) diag.Diagnostics {
+		content, err := yaml.Marshal(&defaultSecurityPolicy)
+		if err != nil {
+			return diag.Errorf("failed to marshal security policy during Delete")
+		}
+
+		err = SendConfigurationPatch(content, m)
+		if err != nil {
+			return diag.Errorf("failed to send PATCH request to Artifactory during Delete")
+		}
+
+		content, err = yaml.Marshal(&defaultPasswordPolicy)
+		if err != nil {
+			return diag.Errorf("failed to marshal password policy during Delete")
+		}
+
+		err = SendAccessConfigurationPatch(content, m)
+		if err != nil {
+			return diag.Errorf("failed to send PATCH request to Access during Delete")
+		}
+
+		return nil
+	}
+
+	return &schema.Resource{
+		UpdateContext: resourceSecurityPolicyUpdate,
+		CreateContext: resourceSecurityPolicyUpdate,
+		DeleteContext: resourceSecurityPolicyDelete,
+		ReadContext:   resourceSecurityPolicyRead,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"hide_unauthorized_resources": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Hide the existence of unauthorized resources: a 404 is returned instead of a 403 when a user accesses a resource without permission. Default value is 'false'.",
+			},
+			"encryption_policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "supported",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"supported", "required", "unsupported"}, false)),
+				Description:      "Whether clients may use clear-text passwords: 'supported' (encrypted and clear-text passwords), 'required' (encrypted passwords only) or 'unsupported' (clear-text passwords only). Default value is 'supported'.",
+			},
+			"password_expiration_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Force the users of the internal realm to change their password periodically. Default value is 'false'.",
+			},
+			"password_max_age": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          60,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Description:      "Number of days after which the password expires. Default value is 60.",
+			},
+			"password_expiration_notify_by_email": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Send an email to the users before their password expires. Default value is 'true'.",
+			},
+			"user_lock_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Lock the users after too many failed login attempts. Default value is 'false'.",
+			},
+			"user_lock_login_attempts": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          5,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 100)),
+				Description:      "Number of failed login attempts after which the user is locked. Default value is 5.",
+			},
+			"password_min_length": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          8,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Description:      "Minimum length of the passwords of the users of the internal realm. Default value is 8.",
+			},
+			"password_min_uppercase": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          1,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+				Description:      "Minimum number of upper case letters in the passwords. Default value is 1.",
+			},
+			"password_min_digits": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          1,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+				Description:      "Minimum number of digits in the passwords. Default value is 1.",
+			},
+			"password_min_special_chars": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          0,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+				Description:      "Minimum number of special characters in the passwords. Default value is 0.",
+			},
+		},
+
+		Description: "Manages the password complexity, password encryption, password expiration and user lock policies of Artifactory, and whether the existence of unauthorized resources is hidden.",
+	}
+}
@@ -0,0 +1,93 @@
+package configuration_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/acctest"
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/artifactory/resource/configuration"
+)
+
+const SecurityPolicyTemplateFull = `
+resource "artifactory_security_policy" "policy" {
+	hide_unauthorized_resources         = true
+	encryption_policy                   = "required"
+	password_expiration_enabled         = true
+	password_max_age                    = 90
+	password_expiration_notify_by_email = false
+	user_lock_enabled                   = true
+	user_lock_login_attempts            = 3
+	password_min_length                 = 12
+	password_min_uppercase              = 2
+	password_min_digits                 = 2
+	password_min_special_chars          = 1
+}`
+
+func TestAccSecurityPolicy_full(t *testing.T) {
+	fqrn := "artifactory_security_policy.policy"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccSecurityPolicyDestroy(fqrn),
+
+		Steps: []resource.TestStep{
+			{
+				Config: SecurityPolicyTemplateFull,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "hide_unauthorized_resources", "true"),
+					resource.TestCheckResourceAttr(fqrn, "encryption_policy", "required"),
+					resource.TestCheckResourceAttr(fqrn, "password_expiration_enabled", "true"),
+					resource.TestCheckResourceAttr(fqrn, "password_max_age", "90"),
+					resource.TestCheckResourceAttr(fqrn, "password_expiration_notify_by_email", "false"),
+					resource.TestCheckResourceAttr(fqrn, "user_lock_enabled", "true"),
+					resource.TestCheckResourceAttr(fqrn, "user_lock_login_attempts", "3"),
+					resource.TestCheckResourceAttr(fqrn, "password_min_length", "12"),
+					resource.TestCheckResourceAttr(fqrn, "password_min_uppercase", "2"),
+					resource.TestCheckResourceAttr(fqrn, "password_min_digits", "2"),
+					resource.TestCheckResourceAttr(fqrn, "password_min_special_chars", "1"),
+				),
+			},
+			{
+				ResourceName:      fqrn,
+				ImportState:       true,
+				ImportStateId:     "security_policy",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSecurityPolicyDestroy(id string) func(*terraform.State) error {
+	return func(s *terraform.State) error {
+		client := acctest.Provider.Meta().(*resty.Client)
+
+		_, ok := s.RootModule().Resources[id]
+		if !ok {
+			return fmt.Errorf("error: resource id [%s] not found", id)
+		}
+
+		policy := configuration.SecurityPolicy{}
+		_, err := client.R().SetResult(&policy).Get("artifactory/api/system/configuration")
+		if err != nil {
+			return fmt.Errorf("error: failed to retrieve data from API: /artifactory/api/system/configuration during Read")
+		}
+		if policy.Settings.HideUnauthorizedResources || policy.Settings.UserLockPolicy.Enabled ||
+			policy.Settings.PasswordSettings.ExpirationPolicy.Enabled || policy.Settings.PasswordSettings.EncryptionPolicy != "supported" {
+			return fmt.Errorf("error: security policy has not been reset to the defaults: %+v", policy.Settings)
+		}
+
+		passwordPolicy, err := configuration.GetPasswordPolicy(client)
+		if err != nil {
+			return err
+		}
+		if passwordPolicy.Length != 8 || passwordPolicy.Uppercase != 1 || passwordPolicy.Digit != 1 || passwordPolicy.SpecialChar != 0 {
+			return fmt.Errorf("error: password policy has not been reset to the defaults: %+v", *passwordPolicy)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,212 @@
+package configuration
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"golang.org/x/crypto/ssh"
+	"gopkg.in/yaml.v3"
+)
+
+// The server keys are not part of the Artifactory configuration, they are uploaded and removed with the endpoint used
+// by the Artifactory UI
+const sshServerKeyEndpoint = "artifactory/ui/sshserver/install"
+
+type SshServer struct {
+	Security struct {
+		Settings SshServerSettings `xml:"sshServerSettings" yaml:"sshServerSettings"`
+	} `xml:"security" yaml:"security"`
+}
+
+type SshServerSettings struct {
+	EnableSshServer bool   `xml:"enableSshServer" yaml:"enableSshServer"`
+	SshServerPort   int    `xml:"sshServerPort" yaml:"sshServerPort"`
+	CustomUrlBase   string `xml:"customUrlBase" yaml:"customUrlBase"`
+}
+
+// default SSH server settings of Artifactory, restored when the resource is destroyed
+var defaultSshServerSettings = SshServerSettings{
+	EnableSshServer: false,
+	SshServerPort:   1339,
+	CustomUrlBase:   "",
+}
+
+// uploadSshServerKey uploads the public or private key of the SSH server, or removes it when key is empty
+func uploadSshServerKey(c *resty.Client, public bool, key string) error {
+	name := "private"
+	if public {
+		name = "public"
+	}
+
+	request := c.R().SetQueryParam("public", strconv.FormatBool(public))
+	if key == "" {
+		resp, err := request.Delete(sshServerKeyEndpoint)
+		if err != nil && (resp == nil || resp.StatusCode() != http.StatusNotFound) {
+			return fmt.Errorf("failed to remove the SSH server %s key: %s", name, err)
+		}
+		return nil
+	}
+
+	_, err := request.
+		SetFileReader("file", name+".key", strings.NewReader(key)).
+		Post(sshServerKeyEndpoint)
+	if err != nil {
+		return fmt.Errorf("failed to upload the SSH server %s key: %s", name, err)
+	}
+	return nil
+}
+
+func validateSshServerPrivateKey(value interface{}, _ cty.Path) diag.Diagnostics {
+	if _, err := ssh.ParseRawPrivateKey([]byte(value.(string))); err != nil {
+		return diag.Errorf("SSH server private key must be an unencrypted PEM private key: %s", err)
+	}
+	return nil
+}
+
+func ResourceArtifactorySshServerSettings() *schema.Resource {
+	var unpackSshServerSettings = func(s *schema.ResourceData) *SshServer {
+		d := &util.ResourceData{ResourceData: s}
+		sshServer := &SshServer{}
+		sshServer.Security.Settings = SshServerSettings{
+			EnableSshServer: d.GetBool("enable", false),
+			SshServerPort:   d.GetInt("port", false),
+			CustomUrlBase:   d.GetString("custom_url_base", false),
+		}
+		return sshServer
+	}
+
+	var packSshServerSettings = func(sshServer *SshServer, d *schema.ResourceData) diag.Diagnostics {
+		setValue := util.MkLens(d)
+
+		settings := sshServer.Security.Settings
+		setValue("enable", settings.EnableSshServer)
+		setValue("port", settings.SshServerPort)
+		errors := setValue("custom_url_base", settings.CustomUrlBase)
+
+		if errors != nil && len(errors) > 0 {
+			return diag.Errorf("failed to pack SSH server settings %q", errors)
+		}
+
+		return nil
+	}
+
+	var resourceSshServerSettingsRead = func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		sshServer := &SshServer{}
+
+		_, err := m.(*resty.Client).R().SetResult(sshServer).Get("artifactory/api/system/configuration")
+		if err != nil {
+			return diag.Errorf("failed to retrieve data from API: /artifactory/api/system/configuration during Read")
+		}
+
+		return packSshServerSettings(sshServer, d)
+	}
+
+	var resourceSshServerSettingsUpdate = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		content, err := yaml.Marshal(unpackSshServerSettings(d))
+		if err != nil {
+			return diag.Errorf("failed to marshal SSH server settings during Update")
+		}
+
+		err = SendConfigurationPatch(content, m)
+		if err != nil {
+			return diag.Errorf("failed to send PATCH request to Artifactory during Update")
+		}
+
+		// the keys can't be read back, they are only uploaded when changed
+		for _, key := range []string{"public_key", "private_key"} {
+			if d.HasChange(key) {
+				if err := uploadSshServerKey(m.(*resty.Client), key == "public_key", d.Get(key).(string)); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+		}
+
+		// we should only have one SSH server settings resource, using same id
+		d.SetId("ssh_server_settings")
+		return resourceSshServerSettingsRead(ctx, d, m)
+	}
+
+	var resourceSshServerSettingsDelete = func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		sshServer := &SshServer{}
+		sshServer.Security.Settings = defaultSshServerSettings
+
+		content, err := yaml.Marshal(sshServer)
+		if err != nil {
+			return diag.Errorf("failed to marshal SSH server settings during Delete")
+		}
+
+		err = SendConfigurationPatch(content, m)
+		if err != nil {
+			return diag.Errorf("failed to send PATCH request to Artifactory during Delete")
+		}
+
+		for _, key := range []string{"public_key", "private_key"} {
+			if d.Get(key).(string) != "" {
+				if err := uploadSshServerKey(m.(*resty.Client), key == "public_key", ""); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+		}
+
+		return nil
+	}
+
+	return &schema.Resource{
+		UpdateContext: resourceSshServerSettingsUpdate,
+		CreateContext: resourceSshServerSettingsUpdate,
+		DeleteContext: resourceSshServerSettingsDelete,
+		ReadContext:   resourceSshServerSettingsRead,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enable": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Enable SSH authentication, used by Git LFS and the JFrog CLI.",
+			},
+			"port": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          1339,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsPortNumber),
+				Description:      "Port of the SSH server. Default value is 1339.",
+			},
+			"custom_url_base": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.Any(validation.StringIsEmpty, validation.IsURLWithScheme([]string{"http", "https"}))),
+				Description:      "Base URL returned to the SSH clients, when it differs from the base URL of Artifactory, e.g. behind a load balancer.",
+			},
+			"public_key": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				RequiredWith:     []string{"private_key"},
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+				Description:      "Public key of the SSH server, in PEM format. Removing it removes the key of the server.",
+			},
+			"private_key": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				RequiredWith:     []string{"public_key"},
+				ValidateDiagFunc: validateSshServerPrivateKey,
+				Description:      "Private key of the SSH server, in PEM format, without passphrase. Removing it removes the key of the server.",
+			},
+		},
+
+		Description: "Manages the SSH server of Artifactory, used to authenticate users with their SSH public key.",
+	}
+}
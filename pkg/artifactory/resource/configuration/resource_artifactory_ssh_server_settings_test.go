@@ -0,0 +1,105 @@
+package configuration_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/acctest"
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/artifactory/resource/configuration"
+)
+
+const SshServerSettingsTemplateFull = `
+resource "artifactory_ssh_server_settings" "ssh" {
+	enable          = true
+	port            = 1340
+	custom_url_base = "https://ssh.example.com"
+}`
+
+func TestAccSshServerSettings_full(t *testing.T) {
+	fqrn := "artifactory_ssh_server_settings.ssh"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccSshServerSettingsDestroy(fqrn),
+
+		Steps: []resource.TestStep{
+			{
+				Config: SshServerSettingsTemplateFull,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "enable", "true"),
+					resource.TestCheckResourceAttr(fqrn, "port", "1340"),
+					resource.TestCheckResourceAttr(fqrn, "custom_url_base", "https://ssh.example.com"),
+				),
+			},
+			{
+				Config: sshServerSettingsWithKeys(t),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "enable", "true"),
+					resource.TestCheckResourceAttrSet(fqrn, "public_key"),
+					resource.TestCheckResourceAttrSet(fqrn, "private_key"),
+				),
+			},
+			{
+				ResourceName:            fqrn,
+				ImportState:             true,
+				ImportStateId:           "ssh_server_settings",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"public_key", "private_key"},
+			},
+		},
+	})
+}
+
+// sshServerSettingsWithKeys returns the SSH server settings with a new RSA key pair in PEM format
+func sshServerSettingsWithKeys(t *testing.T) string {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKey, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return fmt.Sprintf(`
+resource "artifactory_ssh_server_settings" "ssh" {
+	enable      = true
+	public_key  = <<EOF
+%sEOF
+	private_key = <<EOF
+%sEOF
+}`,
+		pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey}),
+		pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}),
+	)
+}
+
+func testAccSshServerSettingsDestroy(id string) func(*terraform.State) error {
+	return func(s *terraform.State) error {
+		client := acctest.Provider.Meta().(*resty.Client)
+
+		_, ok := s.RootModule().Resources[id]
+		if !ok {
+			return fmt.Errorf("error: resource id [%s] not found", id)
+		}
+
+		sshServer := configuration.SshServer{}
+		_, err := client.R().SetResult(&sshServer).Get("artifactory/api/system/configuration")
+		if err != nil {
+			return fmt.Errorf("error: failed to retrieve data from API: /artifactory/api/system/configuration during Read")
+		}
+		if sshServer.Security.Settings.EnableSshServer {
+			return fmt.Errorf("error: SSH server is still enabled: %+v", sshServer.Security.Settings)
+		}
+
+		return nil
+	}
+}
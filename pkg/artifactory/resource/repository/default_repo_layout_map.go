@@ -1,5 +1,7 @@
 package repository
 
+import "github.com/jfrog/terraform-provider-shared/util"
+
 // Consolidated list of Default Repo Layout for all Package Types with active Repo Types
 var defaultRepoLayoutMap = map[string]SupportedRepoClasses{
 	"alpine": {
@@ -11,6 +13,14 @@ var defaultRepoLayoutMap = map[string]SupportedRepoClasses{
 			"federated": true,
 		},
 	},
+	"ansible": {
+		RepoLayoutRef: "simple-default",
+		SupportedRepoTypes: map[string]bool{
+			"local":   true,
+			"remote":  true,
+			"virtual": true,
+		},
+	},
 	"bower": {
 		RepoLayoutRef: "bower-default",
 		SupportedRepoTypes: map[string]bool{
@@ -152,6 +162,14 @@ var defaultRepoLayoutMap = map[string]SupportedRepoClasses{
 			"virtual": true, "federated": true,
 		},
 	},
+	"huggingfaceml": {
+		RepoLayoutRef: "simple-default",
+		SupportedRepoTypes: map[string]bool{
+			"local":   true,
+			"remote":  true,
+			"virtual": true,
+		},
+	},
 	"ivy": {
 		RepoLayoutRef: "ivy-default",
 		SupportedRepoTypes: map[string]bool{
@@ -161,6 +179,12 @@ var defaultRepoLayoutMap = map[string]SupportedRepoClasses{
 			"federated": true,
 		},
 	},
+	"machinelearning": {
+		RepoLayoutRef: "simple-default",
+		SupportedRepoTypes: map[string]bool{
+			"local": true,
+		},
+	},
 	"maven": {
 		RepoLayoutRef: "maven-2-default",
 		SupportedRepoTypes: map[string]bool{
@@ -188,6 +212,14 @@ var defaultRepoLayoutMap = map[string]SupportedRepoClasses{
 			"federated": true,
 		},
 	},
+	"oci": {
+		RepoLayoutRef: "simple-default",
+		SupportedRepoTypes: map[string]bool{
+			"local":   true,
+			"remote":  true,
+			"virtual": true,
+		},
+	},
 	"opkg": {
 		RepoLayoutRef: "simple-default",
 		SupportedRepoTypes: map[string]bool{
@@ -308,3 +340,85 @@ var defaultRepoLayoutMap = map[string]SupportedRepoClasses{
 		},
 	},
 }
+
+// Documented default values of Optional & Computed attributes, shared by all Package Types of a Repo Type
+var baseRepoDefaultValues = map[string]map[string]interface{}{
+	"local": {
+		"includes_pattern": "**/*",
+		"excludes_pattern": "",
+	},
+	"remote": {
+		"includes_pattern":             "**/*",
+		"hard_fail":                    false,
+		"offline":                      false,
+		"blacked_out":                  false,
+		"store_artifacts_locally":      true,
+		"socket_timeout_millis":        15000,
+		"missed_cache_period_seconds":  1800,
+		"share_configuration":          false,
+		"synchronize_properties":       false,
+		"block_mismatching_mime_types": true,
+		"allow_any_host_auth":          false,
+		"enable_cookie_management":     false,
+		"bypass_head_requests":         false,
+		"priority_resolution":          false,
+	},
+	"virtual": {},
+	"federated": {
+		"includes_pattern": "**/*",
+		"excludes_pattern": "",
+	},
+}
+
+// Package Type specific default values, on top of baseRepoDefaultValues
+var packageRepoDefaultValues = map[string]map[string]map[string]interface{}{
+	"docker": {
+		"remote": {
+			"external_dependencies_enabled": false,
+			"enable_token_authentication":   false,
+			"block_pushing_schema1":         false,
+		},
+	},
+	"gradle": {
+		"virtual": javaVirtualRepoDefaultValues,
+	},
+	"ivy": {
+		"virtual": javaVirtualRepoDefaultValues,
+	},
+	"maven": {
+		"virtual": javaVirtualRepoDefaultValues,
+	},
+	"oci": {
+		"remote": {
+			"external_dependencies_enabled": false,
+			"enable_token_authentication":   false,
+		},
+	},
+	"sbt": {
+		"virtual": javaVirtualRepoDefaultValues,
+	},
+}
+
+var javaVirtualRepoDefaultValues = map[string]interface{}{
+	"force_maven_authentication":               false,
+	"pom_repository_references_cleanup_policy": "discard_active_reference",
+}
+
+// Consolidated table of default values by Package Type & Repo Type. Used to reset an Optional & Computed attribute
+// to the server default when it is removed from the Terraform configuration
+var defaultRepoValuesMap = map[string]map[string]map[string]interface{}{}
+
+func init() {
+	for packageType, repoClasses := range defaultRepoLayoutMap {
+		defaultRepoValuesMap[packageType] = map[string]map[string]interface{}{}
+		for repositoryType, supported := range repoClasses.SupportedRepoTypes {
+			if !supported {
+				continue
+			}
+			defaultRepoValuesMap[packageType][repositoryType] = util.MergeMaps(
+				baseRepoDefaultValues[repositoryType],
+				packageRepoDefaultValues[packageType][repositoryType],
+			)
+		}
+	}
+}
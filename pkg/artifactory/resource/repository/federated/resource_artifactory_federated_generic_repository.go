@@ -43,6 +43,9 @@ func ResourceArtifactoryFederatedGenericRepository(repoType string) *schema.Reso
 			},
 		},
 	}, repository.RepoLayoutRefSchema("federated", repoType))
+	// renaming is not supported for federated repositories, as every federated member would need to be renamed
+	delete(federatedSchema, "moved_from")
+	delete(federatedSchema, "moved_to")
 
 	type Member struct {
 		Url     string `hcl:"url" json:"url"`
@@ -129,5 +132,5 @@ func ResourceArtifactoryFederatedGenericRepository(repoType string) *schema.Reso
 		}
 	}
 
-	return repository.MkResourceSchema(federatedSchema, pkr, unpackFederatedRepository, constructor)
+	return repository.MkResourceSchema(federatedSchema, pkr, unpackFederatedRepository, constructor, "federated", local.GetPackageType(repoType))
 }
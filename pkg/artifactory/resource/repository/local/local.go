@@ -10,6 +10,7 @@ import (
 )
 
 var RepoTypesLikeGeneric = []string{
+	"ansible",
 	"bower",
 	"chef",
 	"cocoapods",
@@ -22,6 +23,8 @@ var RepoTypesLikeGeneric = []string{
 	"gitlfs",
 	"go",
 	"helm",
+	"huggingfaceml",
+	"machinelearning",
 	"npm",
 	"opkg",
 	"pub",
@@ -41,7 +44,7 @@ type RepositoryBaseParams struct {
 	Description            string   `hcl:"description" json:"description,omitempty"`
 	Notes                  string   `hcl:"notes" json:"notes,omitempty"`
 	IncludesPattern        string   `hcl:"includes_pattern" json:"includesPattern,omitempty"`
-	ExcludesPattern        string   `hcl:"excludes_pattern" json:"excludesPattern,omitempty"`
+	ExcludesPattern        string   `hcl:"excludes_pattern" json:"excludesPattern"`
 	RepoLayoutRef          string   `hcl:"repo_layout_ref" json:"repoLayoutRef,omitempty"`
 	BlackedOut             *bool    `hcl:"blacked_out" json:"blackedOut,omitempty"`
 	XrayIndex              bool     `json:"xrayIndex"`
@@ -60,10 +63,11 @@ var BaseLocalRepoSchema = map[string]*schema.Schema{
 	"key": {
 		Type:         schema.TypeString,
 		Required:     true,
-		ForceNew:     true,
 		ValidateFunc: repository.RepoKeyValidator,
 		Description:  "A mandatory identifier for the repository that must be unique. Must be 3 - 10 lowercase alphanumeric and hyphen characters. It cannot begin with a number or contain spaces or special characters.",
 	},
+	"moved_from": repository.MovedFromSchema,
+	"moved_to":   repository.MovedToSchema,
 	"project_key": {
 		Type:             schema.TypeString,
 		Optional:         true,
@@ -187,6 +191,7 @@ var schemaRepoTypeLookup = map[string]map[string]*schema.Schema{
 	"ivy":                getJavaRepoSchema("ivy", false),
 	"maven":              getJavaRepoSchema("maven", false),
 	"nuget":              nugetLocalSchema,
+	"oci":                ociLocalSchema,
 	"rpm":                rpmLocalSchema,
 	"sbt":                getJavaRepoSchema("sbt", false),
 	"terraform_module":   getTerraformLocalSchema("module"),
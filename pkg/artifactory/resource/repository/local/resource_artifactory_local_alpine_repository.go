@@ -45,5 +45,5 @@ func ResourceArtifactoryLocalAlpineRepository() *schema.Resource {
 				Rclass:      "local",
 			},
 		}
-	})
+	}, "local", "alpine")
 }
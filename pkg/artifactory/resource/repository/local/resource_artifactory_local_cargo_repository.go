@@ -45,5 +45,5 @@ func ResourceArtifactoryLocalCargoRepository() *schema.Resource {
 				Rclass:      "local",
 			},
 		}
-	})
+	}, "local", "cargo")
 }
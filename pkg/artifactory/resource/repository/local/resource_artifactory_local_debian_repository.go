@@ -60,5 +60,5 @@ func ResourceArtifactoryLocalDebianRepository() *schema.Resource {
 				Rclass:      "local",
 			},
 		}
-	})
+	}, "local", "debian")
 }
@@ -69,7 +69,7 @@ func ResourceArtifactoryLocalDockerV2Repository() *schema.Resource {
 			MaxUniqueTags:       0, // no limit
 			BlockPushingSchema1: true,
 		}
-	})
+	}, "local", "docker")
 }
 
 var dockerV1LocalSchema = util.MergeMaps(
@@ -124,7 +124,7 @@ func ResourceArtifactoryLocalDockerV1Repository() *schema.Resource {
 			MaxUniqueTags:       0,
 			BlockPushingSchema1: false,
 		}
-	})
+	}, "local", "docker")
 }
 
 type DockerLocalRepositoryParams struct {
@@ -0,0 +1,382 @@
+package local
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/artifactory/datasource"
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/artifactory/resource/repository"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+const AqlEndpoint = "artifactory/api/search/aql"
+
+// Docker tags pushed by digest only are stored in a folder named after the digest
+const untaggedManifestPrefix = "sha256__"
+
+// Manifest of a multi-arch image, referencing the manifest of each platform
+const listManifestName = "list.manifest.json"
+
+type DockerRetentionPolicy struct {
+	Repository                  string
+	KeepNewestSemver            int
+	KeepTagsMatching            []*regexp.Regexp
+	DeleteUntaggedOlderThanDays int
+}
+
+type DockerManifestItem struct {
+	Path    string    `json:"path"`
+	Name    string    `json:"name"`
+	Created time.Time `json:"created"`
+	// digests of the platform manifests referenced by a `list.manifest.json`
+	References []string `json:"-"`
+}
+
+// Image returns the image name of the manifest, e.g. `my-org/my-app`
+func (i DockerManifestItem) Image() string {
+	return path.Dir(i.Path)
+}
+
+// Tag returns the tag of the manifest, which is the name of the folder the manifest is stored in
+func (i DockerManifestItem) Tag() string {
+	return path.Base(i.Path)
+}
+
+// Digest returns the digest of a manifest pushed by digest only, e.g. `sha256:abc` for the folder `sha256__abc`
+func (i DockerManifestItem) Digest() string {
+	return strings.Replace(i.Tag(), "__", ":", 1)
+}
+
+func (i DockerManifestItem) imageTag() string {
+	return fmt.Sprintf("%s:%s", i.Image(), i.Tag())
+}
+
+type dockerManifestList struct {
+	Manifests []struct {
+		Digest string `json:"digest"`
+	} `json:"manifests"`
+}
+
+type AqlItems struct {
+	Results []DockerManifestItem `json:"results"`
+}
+
+var dockerRetentionPolicySchema = map[string]*schema.Schema{
+	"repository": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: repository.RepoKeyValidator,
+		Description:  "Key of the local Docker repository the policy applies to.",
+	},
+	"keep_newest_semver": {
+		Type:             schema.TypeInt,
+		Optional:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+		Description:      "Number of most recent semantic version tags to keep for each image. Older semantic version tags are deleted. Tags which are not semantic versions are not affected. When not set, semantic version tags are not deleted.",
+	},
+	"keep_tags_matching": {
+		Type: schema.TypeList,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringIsValidRegExp,
+		},
+		Optional:    true,
+		Description: "List of regular expressions. Tags matching any of them are never deleted, e.g. `^prod-.*`.",
+	},
+	"delete_untagged_older_than_days": {
+		Type:             schema.TypeInt,
+		Optional:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+		Description:      "Delete manifests pushed without a tag (stored by digest) which were created more than this number of days ago. When not set, untagged manifests are not deleted.",
+	},
+	"dry_run": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "When set, the tags to delete are listed in `deletions` but are not deleted. Default value is 'false'.",
+	},
+	"deletions": {
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Computed:    true,
+		Description: "List of `image:tag` matched by the policy during plan, which the apply deletes (unless `dry_run` is set). Only the listed tags are deleted.",
+	},
+	"deleted": {
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Computed:    true,
+		Description: "List of `image:tag` deleted by the last apply. Empty with `dry_run`.",
+	},
+}
+
+func unpackDockerRetentionPolicy(data retentionPolicyData) DockerRetentionPolicy {
+	policy := DockerRetentionPolicy{
+		Repository: data.Get("repository").(string),
+	}
+	if v, ok := data.GetOk("keep_newest_semver"); ok {
+		policy.KeepNewestSemver = v.(int)
+	} else {
+		policy.KeepNewestSemver = -1
+	}
+	for _, pattern := range data.Get("keep_tags_matching").([]interface{}) {
+		policy.KeepTagsMatching = append(policy.KeepTagsMatching, regexp.MustCompile(pattern.(string)))
+	}
+	policy.DeleteUntaggedOlderThanDays = data.Get("delete_untagged_older_than_days").(int)
+
+	return policy
+}
+
+// retentionPolicyData common methods of schema.ResourceData and schema.ResourceDiff used to unpack the policy
+type retentionPolicyData interface {
+	Get(string) interface{}
+	GetOk(string) (interface{}, bool)
+}
+
+func (p DockerRetentionPolicy) keepTag(tag string) bool {
+	for _, pattern := range p.KeepTagsMatching {
+		if pattern.MatchString(tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// Evaluate returns the sorted list of `image:tag` to delete according to the policy
+//
+// The platform manifests of a multi-arch image are stored by digest, like untagged manifests. They are only deleted
+// when no remaining `list.manifest.json` references them.
+func (p DockerRetentionPolicy) Evaluate(items []DockerManifestItem, now time.Time) []string {
+	semverTagsByImage := map[string][]string{}
+	deleted := map[string]bool{}
+	var untagged []DockerManifestItem
+
+	for _, item := range items {
+		tag := item.Tag()
+		if p.keepTag(tag) {
+			continue
+		}
+
+		if strings.HasPrefix(tag, untaggedManifestPrefix) {
+			if p.DeleteUntaggedOlderThanDays > 0 && item.Created.Before(now.AddDate(0, 0, -p.DeleteUntaggedOlderThanDays)) {
+				untagged = append(untagged, item)
+			}
+			continue
+		}
+
+		if datasource.IsSemver(tag) {
+			semverTagsByImage[item.Image()] = append(semverTagsByImage[item.Image()], tag)
+		}
+	}
+
+	if p.KeepNewestSemver >= 0 {
+		for image, tags := range semverTagsByImage {
+			datasource.SortTagsBySemver(tags)
+			if len(tags) <= p.KeepNewestSemver {
+				continue
+			}
+			for _, tag := range tags[:len(tags)-p.KeepNewestSemver] {
+				deleted[fmt.Sprintf("%s:%s", image, tag)] = true
+			}
+		}
+	}
+
+	for _, item := range untagged {
+		if item.Name == listManifestName {
+			deleted[item.imageTag()] = true
+		}
+	}
+
+	referenced := map[string]bool{}
+	for _, item := range items {
+		if item.Name == listManifestName && !deleted[item.imageTag()] {
+			for _, digest := range item.References {
+				referenced[fmt.Sprintf("%s@%s", item.Image(), digest)] = true
+			}
+		}
+	}
+
+	for _, item := range untagged {
+		if item.Name != listManifestName && !referenced[fmt.Sprintf("%s@%s", item.Image(), item.Digest())] {
+			deleted[item.imageTag()] = true
+		}
+	}
+
+	deletions := maps.Keys(deleted)
+	sort.Strings(deletions)
+	return deletions
+}
+
+func findDockerManifests(c *resty.Client, repo string) ([]DockerManifestItem, error) {
+	query := fmt.Sprintf(
+		`items.find({"repo":"%s","name":{"$match":"*manifest.json"}}).include("path","name","created")`,
+		repo,
+	)
+
+	items := AqlItems{}
+	_, err := c.R().
+		SetHeader("Content-Type", "text/plain").
+		SetBody(query).
+		SetResult(&items).
+		Post(AqlEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	// `manifest.json` for images and `list.manifest.json` for multi-arch indexes
+	manifests := []DockerManifestItem{}
+	for _, item := range items.Results {
+		if item.Name == listManifestName {
+			list := dockerManifestList{}
+			_, err := c.R().
+				SetResult(&list).
+				Get(fmt.Sprintf("artifactory/%s/%s/%s", repo, item.Path, item.Name))
+			if err != nil {
+				return nil, fmt.Errorf("failed to read %s/%s: %s", item.Path, item.Name, err)
+			}
+			for _, manifest := range list.Manifests {
+				item.References = append(item.References, manifest.Digest)
+			}
+		}
+		if item.Name == "manifest.json" || item.Name == listManifestName {
+			manifests = append(manifests, item)
+		}
+	}
+	return manifests, nil
+}
+
+func ResourceArtifactoryDockerRetentionPolicy() *schema.Resource {
+	var evaluatePolicy = func(data retentionPolicyData, m interface{}) ([]string, error) {
+		policy := unpackDockerRetentionPolicy(data)
+		manifests, err := findDockerManifests(m.(*resty.Client), policy.Repository)
+		if err != nil {
+			return nil, err
+		}
+		return policy.Evaluate(manifests, time.Now()), nil
+	}
+
+	var resourceDockerRetentionPolicyRead = func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		resp, err := repository.CheckRepo(d.Id(), m.(*resty.Client).R())
+		if err != nil {
+			if resp != nil && (resp.StatusCode() == http.StatusBadRequest || resp.StatusCode() == http.StatusNotFound) {
+				d.SetId("")
+				return nil
+			}
+			return diag.FromErr(err)
+		}
+
+		return diag.FromErr(d.Set("repository", d.Id()))
+	}
+
+	// resourceDockerRetentionPolicyApply deletes the tags listed in `deletions` during plan. The policy isn't
+	// evaluated again, tags matching it since the plan are deleted by the next apply.
+	var resourceDockerRetentionPolicyApply = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		repo := d.Get("repository").(string)
+		d.SetId(repo)
+
+		deleted := []string{}
+		if !d.Get("dry_run").(bool) {
+			for _, deletion := range util.CastToStringArr(d.Get("deletions").([]interface{})) {
+				imageTag := strings.Replace(deletion, ":", "/", 1)
+				resp, err := m.(*resty.Client).R().Delete(fmt.Sprintf("artifactory/%s/%s", repo, imageTag))
+				if err != nil && (resp == nil || resp.StatusCode() != http.StatusNotFound) {
+					setValue := util.MkLens(d)
+					setValue("deleted", deleted)
+					return diag.Errorf("failed to delete %s from %s: %s", deletion, repo, err)
+				}
+				deleted = append(deleted, deletion)
+			}
+		}
+
+		if err := d.Set("deleted", deleted); err != nil {
+			return diag.FromErr(err)
+		}
+
+		return resourceDockerRetentionPolicyRead(ctx, d, m)
+	}
+
+	var resourceDockerRetentionPolicyDelete = func(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+		// the policy only exists in Terraform, nothing to delete in Artifactory
+		d.SetId("")
+		return nil
+	}
+
+	// deletionsDiff evaluates the policy during plan so the tags to delete are listed in the plan output
+	var deletionsDiff = func(_ context.Context, diff *schema.ResourceDiff, m interface{}) error {
+		arguments := []string{"repository", "keep_newest_semver", "keep_tags_matching", "delete_untagged_older_than_days", "dry_run"}
+		for _, key := range arguments {
+			if !diff.NewValueKnown(key) {
+				if err := diff.SetNewComputed("deletions"); err != nil {
+					return err
+				}
+				return diff.SetNewComputed("deleted")
+			}
+		}
+
+		deletions, err := evaluatePolicy(diff, m)
+		if err != nil {
+			if diff.Id() != "" {
+				return err
+			}
+			// repository may not exist yet, nothing is deleted by the apply then
+			deletions = []string{}
+		}
+
+		dryRun := diff.Get("dry_run").(bool)
+		deleted := deletions
+		if dryRun {
+			deleted = []string{}
+		}
+
+		oldDeletions := util.CastToStringArr(diff.Get("deletions").([]interface{}))
+		oldDeleted := util.CastToStringArr(diff.Get("deleted").([]interface{}))
+		// the last apply deleted the tags it planned
+		processed := !dryRun && slices.Equal(oldDeletions, oldDeleted)
+
+		if diff.Id() != "" && !diff.HasChanges(arguments...) {
+			switch {
+			case len(deletions) == 0 && processed:
+				// nothing left to delete, the state keeps what the last apply deleted
+				return nil
+			case slices.Equal(oldDeletions, deletions) && slices.Equal(oldDeleted, deleted):
+				if processed {
+					// the same tags were pushed again since they were deleted
+					return diff.SetNewComputed("deleted")
+				}
+				return nil
+			}
+		}
+
+		if err := diff.SetNew("deletions", deletions); err != nil {
+			return err
+		}
+		return diff.SetNew("deleted", deleted)
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceDockerRetentionPolicyApply,
+		ReadContext:   resourceDockerRetentionPolicyRead,
+		UpdateContext: resourceDockerRetentionPolicyApply,
+		DeleteContext: resourceDockerRetentionPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema:        dockerRetentionPolicySchema,
+		CustomizeDiff: deletionsDiff,
+		Description:   "Provides a retention policy for a local Docker repository. The policy is evaluated with AQL on every plan, and the tags listed in the plan are deleted on apply.",
+	}
+}
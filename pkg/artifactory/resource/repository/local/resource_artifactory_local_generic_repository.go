@@ -26,5 +26,5 @@ func ResourceArtifactoryLocalGenericRepository(repoType string) *schema.Resource
 
 	genericRepoSchema := getGenericRepoSchema(repoType)
 
-	return repository.MkResourceSchema(genericRepoSchema, packer.Default(genericRepoSchema), unpack, constructor)
+	return repository.MkResourceSchema(genericRepoSchema, packer.Default(genericRepoSchema), unpack, constructor, "local", repoType)
 }
@@ -105,5 +105,5 @@ func ResourceArtifactoryLocalJavaRepository(repoType string, suppressPom bool) *
 			},
 			SuppressPomConsistencyChecks: suppressPom,
 		}
-	})
+	}, "local", repoType)
 }
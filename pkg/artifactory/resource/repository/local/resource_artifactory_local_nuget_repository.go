@@ -56,5 +56,5 @@ func ResourceArtifactoryLocalNugetRepository() *schema.Resource {
 			MaxUniqueSnapshots:       0,
 			ForceNugetAuthentication: false,
 		}
-	})
+	}, "local", "nuget")
 }
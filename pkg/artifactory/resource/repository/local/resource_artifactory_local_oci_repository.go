@@ -0,0 +1,63 @@
+package local
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/artifactory/resource/repository"
+	"github.com/jfrog/terraform-provider-shared/packer"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+var ociLocalSchema = util.MergeMaps(
+	BaseLocalRepoSchema,
+	map[string]*schema.Schema{
+		"max_unique_tags": {
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  0,
+			Description: "The maximum number of unique tags of a single OCI image to store in this repository.\n" +
+				"Once the number tags for an image exceeds this setting, older tags are removed. A value of 0 (default) indicates there is no limit.",
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+		},
+		"tag_retention": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Default:          1,
+			Description:      "If greater than 1, overwritten tags will be saved by their digest, up to the set up number. Default value is 1.",
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+		},
+	},
+	repository.RepoLayoutRefSchema("local", "oci"),
+)
+
+type OciLocalRepositoryParams struct {
+	RepositoryBaseParams
+	MaxUniqueTags int `hcl:"max_unique_tags" json:"maxUniqueTags"`
+	TagRetention  int `hcl:"tag_retention" json:"dockerTagRetention"`
+}
+
+func ResourceArtifactoryLocalOciRepository() *schema.Resource {
+	const packageType = "oci"
+
+	var unPackLocalOciRepository = func(data *schema.ResourceData) (interface{}, string, error) {
+		d := &util.ResourceData{ResourceData: data}
+		repo := OciLocalRepositoryParams{
+			RepositoryBaseParams: UnpackBaseRepo("local", data, packageType),
+			MaxUniqueTags:        d.GetInt("max_unique_tags", false),
+			TagRetention:         d.GetInt("tag_retention", false),
+		}
+
+		return repo, repo.Id(), nil
+	}
+
+	return repository.MkResourceSchema(ociLocalSchema, packer.Default(ociLocalSchema), unPackLocalOciRepository, func() interface{} {
+		return &OciLocalRepositoryParams{
+			RepositoryBaseParams: RepositoryBaseParams{
+				PackageType: packageType,
+				Rclass:      "local",
+			},
+			TagRetention:  1,
+			MaxUniqueTags: 0, // no limit
+		}
+	}, "local", packageType)
+}
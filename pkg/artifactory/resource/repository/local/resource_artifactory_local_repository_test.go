@@ -6,6 +6,7 @@ import (
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 	"math/rand"
+	"net/http"
 	"regexp"
 	"strings"
 	"testing"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/acctest"
 	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/artifactory/resource/repository"
 	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/artifactory/resource/repository/local"
@@ -461,6 +463,41 @@ func TestAccLocalDockerV2Repository(t *testing.T) {
 	})
 }
 
+func TestAccLocalOciRepository(t *testing.T) {
+
+	_, fqrn, name := test.MkNames("oci-local", "artifactory_local_oci_repository")
+	params := map[string]interface{}{
+		"retention": test.RandSelect(1, 5, 10),
+		"max_tags":  test.RandSelect(0, 5, 10),
+		"name":      name,
+	}
+	localRepositoryBasic := util.ExecuteTemplate("TestAccLocalOciRepository", `
+		resource "artifactory_local_oci_repository" "{{ .name }}" {
+			key 	     = "{{ .name }}"
+			tag_retention = {{ .retention }}
+			max_unique_tags = {{ .max_tags }}
+		}
+	`, params)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      acctest.VerifyDeleted(fqrn, acctest.CheckRepo),
+		Steps: []resource.TestStep{
+			{
+				Config: localRepositoryBasic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "key", name),
+					resource.TestCheckResourceAttr(fqrn, "package_type", "oci"),
+					resource.TestCheckResourceAttr(fqrn, "tag_retention", fmt.Sprintf("%d", params["retention"])),
+					resource.TestCheckResourceAttr(fqrn, "max_unique_tags", fmt.Sprintf("%d", params["max_tags"])),
+					resource.TestCheckResourceAttr(fqrn, "repo_layout_ref", func() string { r, _ := repository.GetDefaultRepoLayoutRef("local", "oci")(); return r.(string) }()), //Check to ensure repository layout is set as per default even when it is not passed.
+				),
+			},
+		},
+	})
+}
+
 func TestAccLocalDockerV2RepositoryWithDefaultMaxUniqueTagsGH370(t *testing.T) {
 
 	_, fqrn, name := test.MkNames("dockerv2-local", "artifactory_local_docker_v2_repository")
@@ -791,6 +828,74 @@ func TestAccLocalGenericRepositoryWithInvalidProjectEnvironmentsGH318(t *testing
 	})
 }
 
+func TestAccLocalRepository_ResetToDefaultValues(t *testing.T) {
+	_, fqrn, name := test.MkNames("local-test-repo-reset", "artifactory_local_generic_repository")
+
+	params := map[string]interface{}{
+		"name": name,
+	}
+	const withAttributes = `
+		resource "artifactory_local_generic_repository" "{{ .name }}" {
+			key              = "{{ .name }}"
+			includes_pattern = "com/jfrog/**"
+			excludes_pattern = "**/*.tmp"
+		}
+	`
+	const withoutAttributes = `
+		resource "artifactory_local_generic_repository" "{{ .name }}" {
+			key = "{{ .name }}"
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      acctest.VerifyDeleted(fqrn, acctest.CheckRepo),
+		Steps: []resource.TestStep{
+			{
+				Config: util.ExecuteTemplate("TestAccLocalRepository_ResetToDefaultValues", withAttributes, params),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "includes_pattern", "com/jfrog/**"),
+					resource.TestCheckResourceAttr(fqrn, "excludes_pattern", "**/*.tmp"),
+				),
+			},
+			{
+				Config: util.ExecuteTemplate("TestAccLocalRepository_ResetToDefaultValues", withoutAttributes, params),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "includes_pattern", "**/*"),
+					resource.TestCheckResourceAttr(fqrn, "excludes_pattern", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLocalGenericRepositoryWithPropertySetNotFound(t *testing.T) {
+	_, fqrn, name := test.MkNames("generic-local", "artifactory_local_generic_repository")
+
+	config := util.ExecuteTemplate("TestAccLocalGenericRepository", `
+		resource "artifactory_local_generic_repository" "{{ .name }}" {
+		  key           = "{{ .name }}"
+		  property_sets = ["artifactory", "missing-{{ .name }}"]
+		}
+	`, map[string]interface{}{
+		"name": name,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      acctest.VerifyDeleted(fqrn, acctest.CheckRepo),
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(".*property set missing-.* not found.*"),
+			},
+		},
+	})
+}
+
 func TestAccLocalNpmRepository(t *testing.T) {
 
 	_, fqrn, name := test.MkNames("npm-local", "artifactory_local_npm_repository")
@@ -980,3 +1085,201 @@ func TestAccLocalCargoRepository(t *testing.T) {
 		},
 	})
 }
+
+func TestAccLocalRepository_MovedFrom(t *testing.T) {
+	_, fqrn, name := test.MkNames("local-test-repo-move", "artifactory_local_generic_repository")
+	newKey := fmt.Sprintf("%s-renamed", name)
+
+	params := map[string]interface{}{
+		"name":   name,
+		"newKey": newKey,
+	}
+	const original = `
+		resource "artifactory_local_generic_repository" "{{ .name }}" {
+			key = "{{ .name }}"
+		}
+	`
+	const renamed = `
+		resource "artifactory_local_generic_repository" "{{ .name }}" {
+			key        = "{{ .newKey }}"
+			moved_from = "{{ .name }}"
+		}
+	`
+
+	var verifyOldRepoDeleted = func(*terraform.State) error {
+		resp, err := acctest.CheckRepo(name, acctest.GetTestResty(t).R())
+		if err != nil && resp != nil && (resp.StatusCode() == http.StatusBadRequest || resp.StatusCode() == http.StatusNotFound) {
+			return nil
+		}
+		return fmt.Errorf("error: repository %s still exists", name)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      acctest.VerifyDeleted(fqrn, acctest.CheckRepo),
+		Steps: []resource.TestStep{
+			{
+				Config: util.ExecuteTemplate("TestAccLocalRepository_MovedFrom", original, params),
+				Check:  resource.TestCheckResourceAttr(fqrn, "key", name),
+			},
+			{
+				Config: util.ExecuteTemplate("TestAccLocalRepository_MovedFrom", renamed, params),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "key", newKey),
+					resource.TestCheckResourceAttr(fqrn, "id", newKey),
+					resource.TestCheckResourceAttr(fqrn, "moved_to", ""),
+					verifyOldRepoDeleted,
+				),
+			},
+		},
+	})
+}
+
+func TestAccLocalRepository_MovedFromExistingRepo(t *testing.T) {
+	_, fqrn, name := test.MkNames("local-test-repo-move", "artifactory_local_generic_repository")
+	newKey := name + "-renamed"
+
+	params := map[string]interface{}{
+		"name":   name,
+		"newKey": newKey,
+	}
+	const original = `
+		resource "artifactory_local_generic_repository" "{{ .name }}" {
+			key = "{{ .name }}"
+		}
+
+		resource "artifactory_local_generic_repository" "{{ .newKey }}" {
+			key = "{{ .newKey }}"
+		}
+	`
+	const renamed = `
+		resource "artifactory_local_generic_repository" "{{ .name }}" {
+			key        = "{{ .newKey }}"
+			moved_from = "{{ .name }}"
+		}
+
+		resource "artifactory_local_generic_repository" "{{ .newKey }}" {
+			key = "{{ .newKey }}"
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      acctest.VerifyDeleted(fqrn, acctest.CheckRepo),
+		Steps: []resource.TestStep{
+			{
+				Config: util.ExecuteTemplate("TestAccLocalRepository_MovedFromExistingRepo", original, params),
+				Check:  resource.TestCheckResourceAttr(fqrn, "key", name),
+			},
+			{
+				Config:      util.ExecuteTemplate("TestAccLocalRepository_MovedFromExistingRepo", renamed, params),
+				ExpectError: regexp.MustCompile(fmt.Sprintf("repository %s already exists", newKey)),
+			},
+		},
+	})
+}
+
+func TestDockerRetentionPolicyEvaluate(t *testing.T) {
+	now := time.Date(2022, 9, 1, 0, 0, 0, 0, time.UTC)
+	item := func(path string, age time.Duration) local.DockerManifestItem {
+		return local.DockerManifestItem{Path: path, Name: "manifest.json", Created: now.Add(-age)}
+	}
+	items := []local.DockerManifestItem{
+		item("my-org/app/1.0.0", 0),
+		item("my-org/app/1.1.0", 0),
+		item("my-org/app/1.10.0", 0),
+		item("my-org/app/1.2.0", 0),
+		item("my-org/app/prod-1.0.0", 0),
+		item("my-org/app/latest", 0),
+		item("my-org/app/sha256__aaaa", 15*24*time.Hour),
+		item("my-org/app/sha256__bbbb", 13*24*time.Hour),
+		item("other/2.0.0", 0),
+	}
+
+	policy := local.DockerRetentionPolicy{
+		KeepNewestSemver:            2,
+		KeepTagsMatching:            []*regexp.Regexp{regexp.MustCompile(`^prod-.*`)},
+		DeleteUntaggedOlderThanDays: 14,
+	}
+
+	deletions := policy.Evaluate(items, now)
+
+	expected := []string{"my-org/app:1.0.0", "my-org/app:1.1.0", "my-org/app:sha256__aaaa"}
+	if strings.Join(deletions, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected deletions %v, got %v", expected, deletions)
+	}
+
+	t.Run("multi-arch", func(t *testing.T) {
+		list := func(path string, age time.Duration, references ...string) local.DockerManifestItem {
+			return local.DockerManifestItem{Path: path, Name: "list.manifest.json", Created: now.Add(-age), References: references}
+		}
+		const old = 15 * 24 * time.Hour
+		multiArchItems := []local.DockerManifestItem{
+			// platform manifests of the live `latest` multi-arch image
+			list("my-org/app/latest", old, "sha256:amd64", "sha256:arm64"),
+			item("my-org/app/sha256__amd64", old),
+			item("my-org/app/sha256__arm64", old),
+			// platform manifest of a multi-arch image deleted by keep_newest_semver
+			list("my-org/app/1.0.0", old, "sha256:s390x"),
+			item("my-org/app/sha256__s390x", old),
+			list("my-org/app/2.0.0", 0, "sha256:ppc64le"),
+			item("my-org/app/sha256__ppc64le", old),
+			// untagged multi-arch image and its platform manifest
+			list("my-org/app/sha256__index", old, "sha256:riscv64"),
+			item("my-org/app/sha256__riscv64", old),
+			// same digest in another image isn't referenced by `latest`
+			item("other/sha256__amd64", old),
+		}
+
+		multiArchPolicy := local.DockerRetentionPolicy{
+			KeepNewestSemver:            1,
+			DeleteUntaggedOlderThanDays: 14,
+		}
+
+		deletions := multiArchPolicy.Evaluate(multiArchItems, now)
+
+		expected := []string{"my-org/app:1.0.0", "my-org/app:sha256__index", "my-org/app:sha256__riscv64", "my-org/app:sha256__s390x", "other:sha256__amd64"}
+		if strings.Join(deletions, ",") != strings.Join(expected, ",") {
+			t.Errorf("expected deletions %v, got %v", expected, deletions)
+		}
+	})
+}
+
+func TestAccDockerRetentionPolicy_dryRun(t *testing.T) {
+	_, fqrn, name := test.MkNames("docker-retention", "artifactory_docker_retention_policy")
+
+	params := map[string]interface{}{
+		"name": name,
+	}
+	const policy = `
+		resource "artifactory_local_docker_v2_repository" "{{ .name }}" {
+			key = "{{ .name }}"
+		}
+
+		resource "artifactory_docker_retention_policy" "{{ .name }}" {
+			repository                      = artifactory_local_docker_v2_repository.{{ .name }}.key
+			keep_newest_semver              = 20
+			keep_tags_matching              = ["^prod-.*"]
+			delete_untagged_older_than_days = 14
+			dry_run                         = true
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: util.ExecuteTemplate("TestAccDockerRetentionPolicy_dryRun", policy, params),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "repository", name),
+					resource.TestCheckResourceAttr(fqrn, "keep_newest_semver", "20"),
+					resource.TestCheckResourceAttr(fqrn, "deletions.#", "0"),
+					resource.TestCheckResourceAttr(fqrn, "deleted.#", "0"),
+				),
+			},
+		},
+	})
+}
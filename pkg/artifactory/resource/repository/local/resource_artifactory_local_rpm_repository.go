@@ -94,5 +94,5 @@ func ResourceArtifactoryLocalRpmRepository() *schema.Resource {
 			EnableFileListsIndexing: false,
 			GroupFileNames:          "",
 		}
-	})
+	}, "local", "rpm")
 }
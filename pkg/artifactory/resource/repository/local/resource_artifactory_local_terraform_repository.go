@@ -35,5 +35,7 @@ func ResourceArtifactoryLocalTerraformRepository(registryType string) *schema.Re
 				Rclass:      "local",
 			}
 		},
+		"local",
+		"terraform_"+registryType,
 	)
 }
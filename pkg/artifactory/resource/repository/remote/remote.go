@@ -5,7 +5,11 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/artifactory/resource/configuration"
 	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/artifactory/resource/repository"
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/artifactory/resource/security"
+	"github.com/jfrog/terraform-provider-shared/packer"
+	"github.com/jfrog/terraform-provider-shared/unpacker"
 	"github.com/jfrog/terraform-provider-shared/util"
 	"github.com/jfrog/terraform-provider-shared/validator"
 )
@@ -78,6 +82,7 @@ func (bp RepositoryBaseParams) Id() string {
 
 var RepoTypesLikeGeneric = []string{
 	"alpine",
+	"ansible",
 	"chef",
 	"conda",
 	"conan",
@@ -86,6 +91,7 @@ var RepoTypesLikeGeneric = []string{
 	"gems",
 	"generic",
 	"gitlfs",
+	"huggingfaceml",
 	"npm",
 	"opkg",
 	"p2",
@@ -99,10 +105,11 @@ var BaseRemoteRepoSchema = map[string]*schema.Schema{
 	"key": {
 		Type:         schema.TypeString,
 		Required:     true,
-		ForceNew:     true,
 		ValidateFunc: repository.RepoKeyValidator,
 		Description:  "A mandatory identifier for the repository that must be unique. It cannot begin with a number or contain spaces or special characters.",
 	},
+	"moved_from": repository.MovedFromSchema,
+	"moved_to":   repository.MovedToSchema,
 	"project_key": {
 		Type:             schema.TypeString,
 		Optional:         true,
@@ -446,6 +453,35 @@ func getJavaRemoteSchema(repoType string, suppressPom bool) map[string]*schema.S
 	)
 }
 
+// verifyRemoteRepo checks the client TLS certificate and the proxy of the remote repository exist
+func verifyRemoteRepo(d *schema.ResourceData, m interface{}) error {
+	if alias := d.Get("client_tls_certificate").(string); alias != "" && (d.IsNewResource() || d.HasChange("client_tls_certificate")) {
+		cert, err := security.FindCertificate(alias, m)
+		if err != nil {
+			return err
+		}
+		if cert == nil {
+			return fmt.Errorf("client TLS certificate %s not found", alias)
+		}
+	}
+	if key := d.Get("proxy").(string); key != "" && (d.IsNewResource() || d.HasChange("proxy")) {
+		proxy, err := configuration.FindProxy(key, m)
+		if err != nil {
+			return err
+		}
+		if proxy == nil {
+			return fmt.Errorf("proxy %s not found", key)
+		}
+	}
+	return nil
+}
+
+// mkResourceSchema is repository.MkResourceSchema with the verification of the resources referenced by the remote repository
+func mkResourceSchema(skeema map[string]*schema.Schema, packer packer.PackFunc, unpack unpacker.UnpackFunc, constructor repository.Constructor, packageType string) *schema.Resource {
+	resource := repository.MkResourceSchema(skeema, packer, unpack, constructor, "remote", packageType)
+	return repository.WithVerification(resource, verifyRemoteRepo)
+}
+
 func UnpackBaseRemoteRepo(s *schema.ResourceData, packageType string) RepositoryBaseParams {
 	d := &util.ResourceData{ResourceData: s}
 
@@ -37,7 +37,7 @@ func ResourceArtifactoryRemoteBowerRepository() *schema.Resource {
 		return repo, repo.Id(), nil
 	}
 
-	return repository.MkResourceSchema(bowerRemoteSchema, packer.Default(bowerRemoteSchema), unpackBowerRemoteRepo, func() interface{} {
+	return mkResourceSchema(bowerRemoteSchema, packer.Default(bowerRemoteSchema), unpackBowerRemoteRepo, func() interface{} {
 		repoLayout, _ := repository.GetDefaultRepoLayoutRef("remote", packageType)()
 		return &BowerRemoteRepo{
 			RepositoryBaseParams: RepositoryBaseParams{
@@ -46,5 +46,5 @@ func ResourceArtifactoryRemoteBowerRepository() *schema.Resource {
 				RemoteRepoLayoutRef: repoLayout.(string),
 			},
 		}
-	})
+	}, packageType)
 }
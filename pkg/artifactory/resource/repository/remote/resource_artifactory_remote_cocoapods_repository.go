@@ -37,7 +37,7 @@ func ResourceArtifactoryRemoteCocoapodsRepository() *schema.Resource {
 		return repo, repo.Id(), nil
 	}
 
-	return repository.MkResourceSchema(cocoapodsRemoteSchema, packer.Default(cocoapodsRemoteSchema), unpackCocoapodsRemoteRepo, func() interface{} {
+	return mkResourceSchema(cocoapodsRemoteSchema, packer.Default(cocoapodsRemoteSchema), unpackCocoapodsRemoteRepo, func() interface{} {
 		repoLayout, _ := repository.GetDefaultRepoLayoutRef("remote", packageType)()
 		return &CocoapodsRemoteRepo{
 			RepositoryBaseParams: RepositoryBaseParams{
@@ -46,5 +46,5 @@ func ResourceArtifactoryRemoteCocoapodsRepository() *schema.Resource {
 				RemoteRepoLayoutRef: repoLayout.(string),
 			},
 		}
-	})
+	}, packageType)
 }
@@ -37,7 +37,7 @@ func ResourceArtifactoryRemoteComposerRepository() *schema.Resource {
 		return repo, repo.Id(), nil
 	}
 
-	return repository.MkResourceSchema(composerRemoteSchema, packer.Default(composerRemoteSchema), unpackComposerRemoteRepo, func() interface{} {
+	return mkResourceSchema(composerRemoteSchema, packer.Default(composerRemoteSchema), unpackComposerRemoteRepo, func() interface{} {
 		repoLayout, _ := repository.GetDefaultRepoLayoutRef("remote", packageType)()
 		return &ComposerRemoteRepo{
 			RepositoryBaseParams: RepositoryBaseParams{
@@ -46,5 +46,5 @@ func ResourceArtifactoryRemoteComposerRepository() *schema.Resource {
 				RemoteRepoLayoutRef: repoLayout.(string),
 			},
 		}
-	})
+	}, packageType)
 }
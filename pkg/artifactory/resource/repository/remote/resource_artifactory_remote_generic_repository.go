@@ -24,5 +24,5 @@ func ResourceArtifactoryRemoteGenericRepository(pkt string) *schema.Resource {
 
 	mergedRemoteRepoSchema := util.MergeMaps(BaseRemoteRepoSchema, repository.RepoLayoutRefSchema("remote", pkt))
 
-	return repository.MkResourceSchema(mergedRemoteRepoSchema, packer.Default(mergedRemoteRepoSchema), unpack, constructor)
+	return mkResourceSchema(mergedRemoteRepoSchema, packer.Default(mergedRemoteRepoSchema), unpack, constructor, pkt)
 }
@@ -35,7 +35,7 @@ func ResourceArtifactoryRemoteGoRepository() *schema.Resource {
 		return repo, repo.Id(), nil
 	}
 
-	return repository.MkResourceSchema(goRemoteSchema, packer.Default(goRemoteSchema), unpackGoRemoteRepo, func() interface{} {
+	return mkResourceSchema(goRemoteSchema, packer.Default(goRemoteSchema), unpackGoRemoteRepo, func() interface{} {
 		repoLayout, _ := repository.GetDefaultRepoLayoutRef("remote", packageType)()
 		return &GoRemoteRepo{
 			RepositoryBaseParams: RepositoryBaseParams{
@@ -44,5 +44,5 @@ func ResourceArtifactoryRemoteGoRepository() *schema.Resource {
 				RemoteRepoLayoutRef: repoLayout.(string),
 			},
 		}
-	})
+	}, packageType)
 }
@@ -2,7 +2,6 @@ package remote
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/artifactory/resource/repository"
 	"github.com/jfrog/terraform-provider-shared/packer"
 )
 
@@ -14,7 +13,7 @@ func ResourceArtifactoryRemoteJavaRepository(repoType string, suppressPom bool)
 		return repo, repo.Id(), nil
 	}
 
-	return repository.MkResourceSchema(javaRemoteSchema, packer.Default(javaRemoteSchema), unpackJavaRemoteRepo, func() interface{} {
+	return mkResourceSchema(javaRemoteSchema, packer.Default(javaRemoteSchema), unpackJavaRemoteRepo, func() interface{} {
 		return &JavaRemoteRepo{
 			RepositoryBaseParams: RepositoryBaseParams{
 				Rclass:      "remote",
@@ -22,5 +21,5 @@ func ResourceArtifactoryRemoteJavaRepository(repoType string, suppressPom bool)
 			},
 			SuppressPomConsistencyChecks: suppressPom,
 		}
-	})
+	}, repoType)
 }
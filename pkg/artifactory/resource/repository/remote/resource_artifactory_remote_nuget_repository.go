@@ -60,7 +60,7 @@ func ResourceArtifactoryRemoteNugetRepository() *schema.Resource {
 		return repo, repo.Id(), nil
 	}
 
-	return repository.MkResourceSchema(nugetRemoteSchema, packer.Default(nugetRemoteSchema), unpackNugetRemoteRepo, func() interface{} {
+	return mkResourceSchema(nugetRemoteSchema, packer.Default(nugetRemoteSchema), unpackNugetRemoteRepo, func() interface{} {
 		repoLayout, _ := repository.GetDefaultRepoLayoutRef("remote", packageType)()
 		return &NugetRemoteRepo{
 			RepositoryBaseParams: RepositoryBaseParams{
@@ -69,5 +69,5 @@ func ResourceArtifactoryRemoteNugetRepository() *schema.Resource {
 				RemoteRepoLayoutRef: repoLayout.(string),
 			},
 		}
-	})
+	}, packageType)
 }
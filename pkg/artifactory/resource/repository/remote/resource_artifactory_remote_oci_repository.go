@@ -0,0 +1,78 @@
+package remote
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/artifactory/resource/repository"
+	"github.com/jfrog/terraform-provider-shared/packer"
+	"github.com/jfrog/terraform-provider-shared/predicate"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+type OciRemoteRepository struct {
+	RepositoryBaseParams
+	ExternalDependenciesEnabled  bool     `hcl:"external_dependencies_enabled" json:"externalDependenciesEnabled"`
+	ExternalDependenciesPatterns []string `hcl:"external_dependencies_patterns" json:"externalDependenciesPatterns"`
+	EnableTokenAuthentication    bool     `hcl:"enable_token_authentication" json:"enableTokenAuthentication"`
+}
+
+func ResourceArtifactoryRemoteOciRepository() *schema.Resource {
+	const packageType = "oci"
+
+	var ociRemoteSchema = util.MergeMaps(BaseRemoteRepoSchema, map[string]*schema.Schema{
+		"external_dependencies_enabled": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Also known as 'Foreign Layers Caching' on the UI",
+		},
+		"enable_token_authentication": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Enable token (Bearer) based authentication.",
+		},
+		"external_dependencies_patterns": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			RequiredWith: []string{"external_dependencies_enabled"},
+			Description: "An allow list of Ant-style path patterns that determine which foreign layers Artifactory will " +
+				"download from external sources. By default, this is set to '**', which means that foreign layers may be downloaded from any external source.",
+		},
+	}, repository.RepoLayoutRefSchema("remote", packageType))
+
+	var unpackOciRemoteRepo = func(s *schema.ResourceData) (interface{}, string, error) {
+		d := &util.ResourceData{ResourceData: s}
+		repo := OciRemoteRepository{
+			RepositoryBaseParams:         UnpackBaseRemoteRepo(s, packageType),
+			EnableTokenAuthentication:    d.GetBool("enable_token_authentication", false),
+			ExternalDependenciesEnabled:  d.GetBool("external_dependencies_enabled", false),
+			ExternalDependenciesPatterns: d.GetList("external_dependencies_patterns"),
+		}
+		if len(repo.ExternalDependenciesPatterns) == 0 {
+			repo.ExternalDependenciesPatterns = []string{"**"}
+		}
+		return repo, repo.Id(), nil
+	}
+
+	// Same handling of "external_dependencies_patterns" as the Docker remote repository, to match default value behavior in UI.
+	ociRemoteRepoPacker := packer.Universal(
+		predicate.All(
+			predicate.SchemaHasKey(ociRemoteSchema),
+			predicate.NoPassword,
+			predicate.Ignore("external_dependencies_patterns"),
+		),
+	)
+
+	return mkResourceSchema(ociRemoteSchema, ociRemoteRepoPacker, unpackOciRemoteRepo, func() interface{} {
+		return &OciRemoteRepository{
+			RepositoryBaseParams: RepositoryBaseParams{
+				Rclass:      "remote",
+				PackageType: packageType,
+			},
+		}
+	}, packageType)
+}
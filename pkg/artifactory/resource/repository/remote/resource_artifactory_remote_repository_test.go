@@ -77,6 +77,52 @@ func TestAccRemoteKeyHasSpecialCharsFails(t *testing.T) {
 	})
 }
 
+func TestAccRemoteRepository_ClientTlsCertificateNotFound(t *testing.T) {
+	_, fqrn, name := test.MkNames("terraform-remote-test-repo-cert", "artifactory_remote_generic_repository")
+	config := fmt.Sprintf(`
+		resource "artifactory_remote_generic_repository" "%s" {
+			key                    = "%s"
+			url                    = "https://example.com/"
+			client_tls_certificate = "missing-certificate-%s"
+		}
+	`, name, name, name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      acctest.VerifyDeleted(fqrn, acctest.CheckRepo),
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(".*client TLS certificate missing-certificate-.* not found.*"),
+			},
+		},
+	})
+}
+
+func TestAccRemoteRepository_ProxyNotFound(t *testing.T) {
+	_, fqrn, name := test.MkNames("terraform-remote-test-repo-proxy", "artifactory_remote_generic_repository")
+	config := fmt.Sprintf(`
+		resource "artifactory_remote_generic_repository" "%s" {
+			key   = "%s"
+			url   = "https://example.com/"
+			proxy = "missing-proxy-%s"
+		}
+	`, name, name, name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      acctest.VerifyDeleted(fqrn, acctest.CheckRepo),
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(".*proxy missing-proxy-.* not found.*"),
+			},
+		},
+	})
+}
+
 func TestAccRemoteDockerRepository(t *testing.T) {
 	const packageType = "docker"
 	_, testCase := mkNewRemoteTestCase(packageType, t, map[string]interface{}{
@@ -115,6 +161,19 @@ func TestAccRemoteDockerRepositoryWithAdditionalCheckFunctions(t *testing.T) {
 	resource.Test(t, testCase)
 }
 
+func TestAccRemoteOciRepository(t *testing.T) {
+	const packageType = "oci"
+	_, testCase := mkNewRemoteTestCase(packageType, t, map[string]interface{}{
+		"url":                            "https://registry-1.docker.io/",
+		"external_dependencies_enabled":  true,
+		"enable_token_authentication":    true,
+		"priority_resolution":            false,
+		"external_dependencies_patterns": []interface{}{"**/hub.docker.io/**"},
+		"missed_cache_period_seconds":    1800,
+	})
+	resource.Test(t, testCase)
+}
+
 func TestAccRemoteCargoRepository(t *testing.T) {
 	const packageType = "cargo"
 	_, testCase := mkNewRemoteTestCase(packageType, t, map[string]interface{}{
@@ -244,6 +303,56 @@ func TestAccRemoteRepository_ExternalDependenciesDefaults(t *testing.T) {
 	}
 }
 
+func TestAccRemoteRepository_ResetToDefaultValues(t *testing.T) {
+	_, fqrn, name := test.MkNames("remote-test-repo-reset", "artifactory_remote_generic_repository")
+
+	params := map[string]interface{}{
+		"name": name,
+	}
+	const withAttributes = `
+		resource "artifactory_remote_generic_repository" "{{ .name }}" {
+			key                   = "{{ .name }}"
+			url                   = "https://tempurl.org/"
+			includes_pattern      = "com/jfrog/**"
+			hard_fail             = true
+			offline               = true
+			socket_timeout_millis = 25000
+		}
+	`
+	const withoutAttributes = `
+		resource "artifactory_remote_generic_repository" "{{ .name }}" {
+			key = "{{ .name }}"
+			url = "https://tempurl.org/"
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      acctest.VerifyDeleted(fqrn, acctest.CheckRepo),
+		Steps: []resource.TestStep{
+			{
+				Config: util.ExecuteTemplate("TestAccRemoteRepository_ResetToDefaultValues", withAttributes, params),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "includes_pattern", "com/jfrog/**"),
+					resource.TestCheckResourceAttr(fqrn, "hard_fail", "true"),
+					resource.TestCheckResourceAttr(fqrn, "offline", "true"),
+					resource.TestCheckResourceAttr(fqrn, "socket_timeout_millis", "25000"),
+				),
+			},
+			{
+				Config: util.ExecuteTemplate("TestAccRemoteRepository_ResetToDefaultValues", withoutAttributes, params),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "includes_pattern", "**/*"),
+					resource.TestCheckResourceAttr(fqrn, "hard_fail", "false"),
+					resource.TestCheckResourceAttr(fqrn, "offline", "false"),
+					resource.TestCheckResourceAttr(fqrn, "socket_timeout_millis", "15000"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRemoteNpmRepository(t *testing.T) {
 	const packageType = "npm"
 	resource.Test(mkNewRemoteTestCase(packageType, t, map[string]interface{}{
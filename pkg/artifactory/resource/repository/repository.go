@@ -2,15 +2,20 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"reflect"
 	"regexp"
+	"strings"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/artifactory/resource/configuration"
 	"github.com/jfrog/terraform-provider-shared/client"
 	"github.com/jfrog/terraform-provider-shared/packer"
 	"github.com/jfrog/terraform-provider-shared/test"
@@ -92,12 +97,101 @@ func mkRepoRead(pack packer.PackFunc, construct Constructor) schema.ReadContextF
 	}
 }
 
-func mkRepoUpdate(unpack unpacker.UnpackFunc, read schema.ReadContextFunc) schema.UpdateContextFunc {
+// jsonFieldNames maps the attributes of the repository to the JSON fields of the API, naming the attributes of the
+// repository struct and its embedded structs as the packer does
+func jsonFieldNames(t reflect.Type, names map[string]string) map[string]string {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return names
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous {
+			jsonFieldNames(field.Type, names)
+			continue
+		}
+		attribute := util.FieldToHcl(field)
+		jsonName := strings.Split(field.Tag.Get("json"), ",")[0]
+		if attribute != "" && jsonName != "" && jsonName != "-" {
+			names[attribute] = jsonName
+		}
+	}
+	return names
+}
+
+// WithResetValues returns the JSON body of the repository, with the default values of the attributes reset by
+// resetToDefaultValueDiff set explicitly. The fields of the repository structs are omitted when empty, so a reset to
+// false, 0 or "" would otherwise never reach the server.
+func WithResetValues(d *schema.ResourceData, repo interface{}, repositoryType string, packageType string) (interface{}, error) {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return repo, nil
+	}
+
+	reset := map[string]interface{}{}
+	names := jsonFieldNames(reflect.TypeOf(repo), map[string]string{})
+	for key, defaultValue := range GetDefaultRepoValues(repositoryType, packageType) {
+		if !config.Type().HasAttribute(key) || !config.GetAttr(key).IsNull() || !d.HasChange(key) {
+			continue
+		}
+		jsonName, ok := names[key]
+		if !ok {
+			return nil, fmt.Errorf("no JSON field for attribute %s of the %s %s repository", key, repositoryType, packageType)
+		}
+		reset[jsonName] = defaultValue
+	}
+	if len(reset) == 0 {
+		return repo, nil
+	}
+
+	content, err := json.Marshal(repo)
+	if err != nil {
+		return nil, err
+	}
+	body := map[string]interface{}{}
+	if err := json.Unmarshal(content, &body); err != nil {
+		return nil, err
+	}
+	for jsonName, value := range reset {
+		body[jsonName] = value
+	}
+	return body, nil
+}
+
+func mkRepoUpdate(unpack unpacker.UnpackFunc, read schema.ReadContextFunc, rclass string, packageType string) schema.UpdateContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-		repo, key, err := unpack(d)
+		unpacked, key, err := unpack(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		repo, err := WithResetValues(d, unpacked, rclass, packageType)
 		if err != nil {
 			return diag.FromErr(err)
 		}
+
+		// key only changes without forcing a new resource when the repository is renamed with `moved_from`
+		if d.HasChange("key") {
+			movedTo, _ := d.GetChange("moved_to")
+			created, err := moveRepo(ctx, m.(*resty.Client), repo, rclass, d.Id(), key, movedTo.(string) == key)
+			if err != nil {
+				// the repository created with the new key is recorded, so the next apply resumes the rename
+				if created {
+					d.Set("moved_to", key)
+				} else {
+					d.Set("moved_to", "")
+				}
+				return diag.FromErr(err)
+			}
+
+			d.Set("moved_to", "")
+			d.SetId(key)
+			return read(ctx, d, m)
+		}
+
 		// repo must be a pointer
 		_, err = m.(*resty.Client).R().
 			AddRetryCondition(client.RetryOnMergeError).
@@ -142,6 +236,7 @@ var RepoKeyValidator = validation.All(
 
 var RepoTypesSupported = []string{
 	"alpine",
+	"ansible",
 	"bower",
 	"cargo",
 	"chef",
@@ -158,10 +253,13 @@ var RepoTypesSupported = []string{
 	"go",
 	"gradle",
 	"helm",
+	"huggingfaceml",
 	"ivy",
+	"machinelearning",
 	"maven",
 	"npm",
 	"nuget",
+	"oci",
 	"opkg",
 	"p2",
 	"puppet",
@@ -196,6 +294,10 @@ func RepoLayoutRefSchema(repositoryType string, packageType string) map[string]*
 // Artifactory REST API will not accept empty string or null to reset value to not set
 // Instead, using a non-existant value works as a workaround
 // To ensure we don't accidentally set the value to a valid value, we use a UUID v4 string
+//
+// This is not covered by the default values of GetDefaultRepoValues: the fields using it (default_deployment_repo of
+// the virtual repositories, proxy of the replications) are Optional only, so removing them already plans "", and they
+// have no server default to send, the API ignores "" and null for them.
 func HandleResetWithNonExistentValue(d *util.ResourceData, key string) string {
 	value := d.GetString(key, false)
 
@@ -222,22 +324,117 @@ func projectEnvironmentsDiff(_ context.Context, diff *schema.ResourceDiff, _ int
 	return nil
 }
 
-func MkResourceSchema(skeema map[string]*schema.Schema, packer packer.PackFunc, unpack unpacker.UnpackFunc, constructor Constructor) *schema.Resource {
+// propertySetsDiff checks on plan that the property sets of the repository exist
+//
+// The check is skipped while the value is unknown, e.g. when the property sets are referenced by the `id` of
+// artifactory_property_set resources created in the same apply.
+func propertySetsDiff(_ context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if !diff.HasChange("property_sets") || !diff.NewValueKnown("property_sets") {
+		return nil
+	}
+
+	names := diff.Get("property_sets").(*schema.Set).List()
+	if len(names) == 0 {
+		return nil
+	}
+
+	propertySets, err := configuration.GetPropertySets(m)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		if slices.IndexFunc(propertySets, func(propertySet configuration.PropertySet) bool {
+			return propertySet.Name == name.(string)
+		}) == -1 {
+			return fmt.Errorf("property set %s not found", name)
+		}
+	}
+
+	return nil
+}
+
+// resetToDefaultValueDiff Resets Optional & Computed attributes to the documented default value
+//
+// Removing an Optional & Computed attribute from the Terraform configuration does not produce a diff, as the
+// value in the state is kept. Instead, the attribute is planned with the default value for the package type
+// (see defaultRepoValuesMap) so the server value is reset on the next update.
+func resetToDefaultValueDiff(repositoryType string, packageType string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+		// nothing to reset when the resource is being created
+		if diff.Id() == "" {
+			return nil
+		}
+
+		config := diff.GetRawConfig()
+		if config.IsNull() || !config.IsKnown() {
+			return nil
+		}
+
+		for key, defaultValue := range GetDefaultRepoValues(repositoryType, packageType) {
+			if !config.Type().HasAttribute(key) || !config.GetAttr(key).IsNull() {
+				continue
+			}
+
+			if value := diff.Get(key); reflect.DeepEqual(value, defaultValue) {
+				continue
+			}
+
+			if err := diff.SetNew(key, defaultValue); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// MkResourceSchema creates the repository resource. The repository type (rclass) and the package type select the
+// documented default values restored by resetToDefaultValueDiff.
+func MkResourceSchema(skeema map[string]*schema.Schema, packer packer.PackFunc, unpack unpacker.UnpackFunc, constructor Constructor, repositoryType string, packageType string) *schema.Resource {
 	var reader = mkRepoRead(packer, constructor)
+
+	diffs := []schema.CustomizeDiffFunc{
+		projectEnvironmentsDiff,
+		keyChangeDiff,
+		resetToDefaultValueDiff(repositoryType, packageType),
+	}
+	if _, ok := skeema["property_sets"]; ok {
+		diffs = append(diffs, propertySetsDiff)
+	}
+
 	return &schema.Resource{
 		CreateContext: mkRepoCreate(unpack, reader),
 		ReadContext:   reader,
-		UpdateContext: mkRepoUpdate(unpack, reader),
+		UpdateContext: mkRepoUpdate(unpack, reader, repositoryType, packageType),
 		DeleteContext: deleteRepo,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema:        skeema,
-		CustomizeDiff: projectEnvironmentsDiff,
+		CustomizeDiff: customdiff.All(diffs...),
 	}
 }
 
+// WithVerification wraps the create and update of the resource, so verify checks the resources referenced by the
+// repository exist before it is created or updated. The check runs on apply, as the referenced resources may be
+// created by the same plan.
+func WithVerification(resource *schema.Resource, verify func(*schema.ResourceData, interface{}) error) *schema.Resource {
+	var verified = func(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			if err := verify(d, m); err != nil {
+				return diag.FromErr(err)
+			}
+			return f(ctx, d, m)
+		}
+	}
+
+	resource.CreateContext = verified(resource.CreateContext)
+	resource.UpdateContext = verified(resource.UpdateContext)
+	return resource
+}
+
 const RepositoriesEndpoint = "artifactory/api/repositories/"
 
 func CheckRepo(id string, request *resty.Request) (*resty.Response, error) {
@@ -255,6 +452,14 @@ func ValidateRepoLayoutRefSchemaOverride(_ interface{}, _ cty.Path) diag.Diagnos
 	}
 }
 
+// GetDefaultRepoValues return the documented default values of Optional & Computed attributes by Repository Type & Package Type
+func GetDefaultRepoValues(repositoryType string, packageType string) map[string]interface{} {
+	if values, ok := defaultRepoValuesMap[packageType][repositoryType]; ok {
+		return values
+	}
+	return baseRepoDefaultValues[repositoryType]
+}
+
 type SupportedRepoClasses struct {
 	RepoLayoutRef      string
 	SupportedRepoTypes map[string]bool
@@ -0,0 +1,213 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jfrog/terraform-provider-shared/client"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	moveEndpoint        = "artifactory/api/move/"
+	permissionsEndpoint = "artifactory/api/v2/security/permissions/"
+)
+
+var MovedFromSchema = &schema.Schema{
+	Type:     schema.TypeString,
+	Optional: true,
+	Description: "The key of the repository this repository is renamed from. When `key` is changed and `moved_from` is set to the previous key, " +
+		"the repository is migrated to the new key (content, virtual repositories memberships and permission targets) instead of being destroyed and recreated.",
+}
+
+var MovedToSchema = &schema.Schema{
+	Type:     schema.TypeString,
+	Computed: true,
+	Description: "The key of the repository created by a rename that failed before completing. The next apply resumes the rename " +
+		"into this repository instead of failing because it already exists.",
+}
+
+// keyChangeDiff Forces a new resource when the key is changed, unless the change is a rename
+// declared with `moved_from` set to the previous key
+func keyChangeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("key") {
+		return nil
+	}
+
+	if !diff.GetRawConfig().Type().HasAttribute("moved_from") {
+		return diff.ForceNew("key")
+	}
+
+	oldKey, _ := diff.GetChange("key")
+	if movedFrom, ok := diff.GetOk("moved_from"); ok && movedFrom.(string) == oldKey.(string) {
+		return diff.SetNewComputed("moved_to")
+	}
+
+	return diff.ForceNew("key")
+}
+
+type VirtualRepositoryMembership struct {
+	Key                   string   `json:"key"`
+	Repositories          []string `json:"repositories"`
+	DefaultDeploymentRepo string   `json:"defaultDeploymentRepo,omitempty"`
+}
+
+// moveRepo Renames a repository from oldKey to newKey
+//
+// Artifactory does not support renaming a repository, so the repository is created with the new key,
+// the content of a local repository is moved with the move API, the virtual repositories and permission targets
+// referencing the old key are repointed, and only then the old repository is deleted.
+//
+// A repository with the new key is only reused when resume is set, i.e. when the state records it was created by a
+// previous rename that failed. It returns whether the repository with the new key exists, so the caller can record it.
+func moveRepo(ctx context.Context, c *resty.Client, repo interface{}, rclass, oldKey, newKey string, resume bool) (bool, error) {
+	tflog.Info(ctx, fmt.Sprintf("moving repository %s to %s", oldKey, newKey))
+
+	request := c.R().
+		AddRetryCondition(client.RetryOnMergeError).
+		SetBody(repo)
+	var err error
+	if _, checkErr := CheckRepo(newKey, c.R()); checkErr == nil {
+		if !resume {
+			return false, fmt.Errorf("repository %s already exists", newKey)
+		}
+		tflog.Info(ctx, fmt.Sprintf("repository %s already exists, resuming the move of %s", newKey, oldKey))
+		_, err = request.Post(RepositoriesEndpoint + newKey)
+	} else {
+		_, err = request.Put(RepositoriesEndpoint + newKey)
+	}
+	if err != nil {
+		return resume, err
+	}
+
+	if rclass == "local" {
+		_, err = c.R().
+			SetQueryParam("to", "/"+newKey).
+			Post(moveEndpoint + oldKey)
+		if err != nil {
+			return true, fmt.Errorf("failed to move content of repository %s to %s: %s", oldKey, newKey, err)
+		}
+	}
+
+	if err := repointVirtualRepos(c, oldKey, newKey); err != nil {
+		return true, err
+	}
+
+	if err := repointPermissionTargets(c, oldKey, newKey); err != nil {
+		return true, err
+	}
+
+	resp, err := c.R().
+		AddRetryCondition(client.RetryOnMergeError).
+		Delete(RepositoriesEndpoint + oldKey)
+	if err != nil && (resp == nil || resp.StatusCode() != http.StatusNotFound) {
+		return true, err
+	}
+
+	return true, nil
+}
+
+func replaceKey(keys []string, oldKey, newKey string) ([]string, bool) {
+	index := slices.Index(keys, oldKey)
+	if index == -1 {
+		return keys, false
+	}
+
+	replaced := slices.Clone(keys)
+	replaced[index] = newKey
+	return replaced, true
+}
+
+func repointVirtualRepos(c *resty.Client, oldKey, newKey string) error {
+	var virtualRepos []struct {
+		Key string `json:"key"`
+	}
+	_, err := c.R().
+		SetQueryParam("type", "virtual").
+		SetResult(&virtualRepos).
+		Get("artifactory/api/repositories")
+	if err != nil {
+		return err
+	}
+
+	for _, virtualRepo := range virtualRepos {
+		membership := VirtualRepositoryMembership{}
+		_, err := c.R().SetResult(&membership).Get(RepositoriesEndpoint + virtualRepo.Key)
+		if err != nil {
+			return err
+		}
+
+		repositories, found := replaceKey(membership.Repositories, oldKey, newKey)
+		if !found && membership.DefaultDeploymentRepo != oldKey {
+			continue
+		}
+
+		membership.Repositories = repositories
+		if membership.DefaultDeploymentRepo == oldKey {
+			membership.DefaultDeploymentRepo = newKey
+		}
+
+		_, err = c.R().
+			AddRetryCondition(client.RetryOnMergeError).
+			SetBody(membership).
+			Post(RepositoriesEndpoint + virtualRepo.Key)
+		if err != nil {
+			return fmt.Errorf("failed to repoint virtual repository %s to %s: %s", virtualRepo.Key, newKey, err)
+		}
+	}
+
+	return nil
+}
+
+func repointPermissionTargets(c *resty.Client, oldKey, newKey string) error {
+	var permissionTargets []struct {
+		Name string `json:"name"`
+	}
+	_, err := c.R().SetResult(&permissionTargets).Get("artifactory/api/v2/security/permissions")
+	if err != nil {
+		return err
+	}
+
+	for _, permissionTarget := range permissionTargets {
+		// generic map so the rest of the permission target is sent back unchanged
+		target := map[string]interface{}{}
+		_, err := c.R().SetResult(&target).Get(permissionsEndpoint + permissionTarget.Name)
+		if err != nil {
+			return err
+		}
+
+		section, ok := target["repo"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		repositories, ok := section["repositories"].([]interface{})
+		if !ok {
+			continue
+		}
+
+		keys := make([]string, 0, len(repositories))
+		for _, repository := range repositories {
+			keys = append(keys, repository.(string))
+		}
+
+		replaced, found := replaceKey(keys, oldKey, newKey)
+		if !found {
+			continue
+		}
+		section["repositories"] = replaced
+
+		_, err = c.R().
+			AddRetryCondition(client.RetryOnMergeError).
+			SetBody(target).
+			Put(permissionsEndpoint + permissionTarget.Name)
+		if err != nil {
+			return fmt.Errorf("failed to repoint permission target %s to %s: %s", permissionTarget.Name, newKey, err)
+		}
+	}
+
+	return nil
+}
@@ -46,5 +46,5 @@ func ResourceArtifactoryVirtualAlpineRepository() *schema.Resource {
 				},
 			},
 		}
-	})
+	}, "virtual", packageType)
 }
@@ -34,5 +34,7 @@ func ResourceArtifactoryVirtualBowerRepository() *schema.Resource {
 				},
 			}
 		},
+		"virtual",
+		packageType,
 	)
 }
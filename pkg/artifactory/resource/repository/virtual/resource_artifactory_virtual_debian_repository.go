@@ -79,5 +79,5 @@ func ResourceArtifactoryVirtualDebianRepository() *schema.Resource {
 				},
 			},
 		}
-	})
+	}, "virtual", packageType)
 }
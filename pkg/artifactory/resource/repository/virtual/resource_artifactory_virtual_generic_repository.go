@@ -21,7 +21,7 @@ func ResourceArtifactoryVirtualGenericRepository(pkt string) *schema.Resource {
 
 	genericSchema := util.MergeMaps(BaseVirtualRepoSchema, repository.RepoLayoutRefSchema("virtual", pkt))
 
-	return repository.MkResourceSchema(genericSchema, packer.Default(genericSchema), unpack, constructor)
+	return repository.MkResourceSchema(genericSchema, packer.Default(genericSchema), unpack, constructor, "virtual", pkt)
 }
 
 func ResourceArtifactoryVirtualRepositoryWithRetrievalCachePeriodSecs(pkt string) *schema.Resource {
@@ -50,5 +50,7 @@ func ResourceArtifactoryVirtualRepositoryWithRetrievalCachePeriodSecs(pkt string
 		packer.Default(repoWithRetrivalCachePeriodSecsVirtualSchema),
 		unpack,
 		constructor,
+		"virtual",
+		pkt,
 	)
 }
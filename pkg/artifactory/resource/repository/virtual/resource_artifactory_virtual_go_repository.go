@@ -57,5 +57,5 @@ func ResourceArtifactoryVirtualGoRepository() *schema.Resource {
 				PackageType: packageType,
 			},
 		}
-	})
+	}, "virtual", packageType)
 }
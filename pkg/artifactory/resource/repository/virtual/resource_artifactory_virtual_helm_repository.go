@@ -47,5 +47,5 @@ func ResourceArtifactoryVirtualHelmRepository() *schema.Resource {
 		}
 	}
 
-	return repository.MkResourceSchema(helmVirtualSchema, packer.Default(helmVirtualSchema), unpackHelmVirtualRepository, constructor)
+	return repository.MkResourceSchema(helmVirtualSchema, packer.Default(helmVirtualSchema), unpackHelmVirtualRepository, constructor, "virtual", packageType)
 }
@@ -70,6 +70,6 @@ func ResourceArtifactoryVirtualJavaRepository(repoType string) *schema.Resource
 				PackageType: repoType,
 			},
 		}
-	})
+	}, "virtual", repoType)
 
 }
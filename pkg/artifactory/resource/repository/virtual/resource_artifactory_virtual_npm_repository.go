@@ -47,5 +47,7 @@ func ResourceArtifactoryVirtualNpmRepository() *schema.Resource {
 				},
 			}
 		},
+		"virtual",
+		packageType,
 	)
 }
@@ -43,5 +43,5 @@ func ResourceArtifactoryVirtualNugetRepository() *schema.Resource {
 				PackageType: packageType,
 			},
 		}
-	})
+	}, "virtual", packageType)
 }
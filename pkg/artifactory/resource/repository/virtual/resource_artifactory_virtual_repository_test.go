@@ -49,6 +49,48 @@ func TestAccVirtualRepository_basic(t *testing.T) {
 	})
 }
 
+func TestAccVirtualRepository_ResetToDefaultValues(t *testing.T) {
+	_, fqrn, name := test.MkNames("virtual-test-repo-reset", "artifactory_virtual_maven_repository")
+
+	params := map[string]interface{}{
+		"name": name,
+	}
+	const withAttributes = `
+		resource "artifactory_virtual_maven_repository" "{{ .name }}" {
+			key                                      = "{{ .name }}"
+			force_maven_authentication               = true
+			pom_repository_references_cleanup_policy = "nothing"
+		}
+	`
+	const withoutAttributes = `
+		resource "artifactory_virtual_maven_repository" "{{ .name }}" {
+			key = "{{ .name }}"
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      acctest.VerifyDeleted(fqrn, acctest.CheckRepo),
+		Steps: []resource.TestStep{
+			{
+				Config: util.ExecuteTemplate("TestAccVirtualRepository_ResetToDefaultValues", withAttributes, params),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "force_maven_authentication", "true"),
+					resource.TestCheckResourceAttr(fqrn, "pom_repository_references_cleanup_policy", "nothing"),
+				),
+			},
+			{
+				Config: util.ExecuteTemplate("TestAccVirtualRepository_ResetToDefaultValues", withoutAttributes, params),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "force_maven_authentication", "false"),
+					resource.TestCheckResourceAttr(fqrn, "pom_repository_references_cleanup_policy", "discard_active_reference"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccVirtualRepository_reset_default_deployment_repo(t *testing.T) {
 	id := test.RandomInt()
 	name := fmt.Sprintf("foo%d", id)
@@ -59,5 +59,5 @@ func ResourceArtifactoryVirtualRpmRepository() *schema.Resource {
 				PackageType: packageType,
 			},
 		}
-	})
+	}, "virtual", packageType)
 }
@@ -34,11 +34,14 @@ func (bp RepositoryBaseParams) Id() string {
 }
 
 var RepoTypesLikeGeneric = []string{
+	"ansible",
 	"docker",
 	"gems",
 	"generic",
 	"gitlfs",
 	"composer",
+	"huggingfaceml",
+	"oci",
 	"p2",
 	"pub",
 	"puppet",
@@ -58,9 +61,10 @@ var BaseVirtualRepoSchema = map[string]*schema.Schema{
 	"key": {
 		Type:        schema.TypeString,
 		Required:    true,
-		ForceNew:    true,
 		Description: "The Repository Key. A mandatory identifier for the repository and must be unique. It cannot begin with a number or contain spaces or special characters. For local repositories, we recommend using a '-local' suffix (e.g. 'libs-release-local').",
 	},
+	"moved_from": repository.MovedFromSchema,
+	"moved_to":   repository.MovedToSchema,
 	"project_key": {
 		Type:             schema.TypeString,
 		Optional:         true,
@@ -0,0 +1,131 @@
+package security
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func DataSourceArtifactoryCertificates() *schema.Resource {
+	var dataSourceCertificatesRead = func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		var certificates []CertificateDetails
+		if _, err := m.(*resty.Client).R().SetResult(&certificates).Get(CertificateEndpoint); err != nil {
+			return diag.FromErr(err)
+		}
+		sort.Slice(certificates, func(i, j int) bool {
+			return certificates[i].CertificateAlias < certificates[j].CertificateAlias
+		})
+
+		var diags diag.Diagnostics
+		warnExpiryWithin, _ := time.ParseDuration(d.Get("warn_expiry_within").(string))
+		now := time.Now()
+
+		packed := make([]interface{}, 0, len(certificates))
+		for _, cert := range certificates {
+			packed = append(packed, map[string]interface{}{
+				"alias":       cert.CertificateAlias,
+				"fingerprint": cert.FingerPrint,
+				"issued_by":   cert.IssuedBy,
+				"issued_to":   cert.IssuedTo,
+				"not_before":  NormalizeCertificateDate(cert.IssuedOn),
+				"not_after":   NormalizeCertificateDate(cert.ValidUntil),
+			})
+
+			if warnExpiryWithin == 0 {
+				continue
+			}
+			expiring, err := CertificateExpiresWithin(cert.ValidUntil, warnExpiryWithin, now)
+			if err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("unable to parse the expiry date %q of certificate %s", cert.ValidUntil, cert.CertificateAlias),
+					Detail:   err.Error(),
+				})
+				continue
+			}
+			if expiring {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("certificate %s expires on %s", cert.CertificateAlias, cert.ValidUntil),
+					Detail:   fmt.Sprintf("The certificate %s (%s) expires within %s, replace it before it expires.", cert.CertificateAlias, cert.IssuedTo, warnExpiryWithin),
+				})
+			}
+		}
+
+		d.SetId("certificates")
+
+		setValue := util.MkLens(d)
+		errors := setValue("certificates", packed)
+		if errors != nil && len(errors) > 0 {
+			return append(diags, diag.Errorf("failed to pack certificates %q", errors)...)
+		}
+
+		return diags
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceCertificatesRead,
+
+		Schema: map[string]*schema.Schema{
+			"warn_expiry_within": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateDiagFunc: validation.ToDiagFunc(func(i interface{}, k string) ([]string, []error) {
+					if d, err := time.ParseDuration(i.(string)); err != nil || d <= 0 {
+						return nil, []error{fmt.Errorf("%q must be a positive duration, e.g. '720h', got %q", k, i)}
+					}
+					return nil, nil
+				}),
+				Description: "Raise a warning for each certificate expiring within this duration, e.g. '720h' for 30 days. Expired certificates are also reported.",
+			},
+			"certificates": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alias": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Alias of the certificate.",
+						},
+						"fingerprint": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "SHA256 fingerprint of the certificate.",
+						},
+						"issued_by": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Common name of the issuer of the certificate, as `issued_by` of the `artifactory_certificate` resource.",
+						},
+						"issued_to": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Common name of the subject of the certificate, as `issued_to` of the `artifactory_certificate` resource.",
+						},
+						"not_before": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Start of the validity period of the certificate.",
+						},
+						"not_after": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "End of the validity period of the certificate.",
+						},
+					},
+				},
+				Description: "Certificates uploaded to Artifactory, sorted by alias.",
+			},
+		},
+
+		Description: "Provides the list of the certificates uploaded to Artifactory, and warns about the certificates about to expire.",
+	}
+}
@@ -0,0 +1,82 @@
+package security_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/acctest"
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/artifactory/resource/security"
+	"github.com/jfrog/terraform-provider-shared/test"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccDataSourceCertificates(t *testing.T) {
+	_, fqrn, name := test.MkNames("cert", "artifactory_certificate")
+
+	config := util.ExecuteTemplate("TestAccDataSourceCertificates", `
+		resource "artifactory_certificate" "{{ .name }}" {
+			alias = "{{ .name }}"
+			file  = "../../../../samples/cert.pem"
+		}
+
+		data "artifactory_certificates" "all" {
+			warn_expiry_within = "720h"
+
+			depends_on = [artifactory_certificate.{{ .name }}]
+		}
+	`, map[string]string{"name": name})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckCertificateDestroy(fqrn),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.artifactory_certificates.all", "certificates.*", map[string]string{
+						"alias":       name,
+						"fingerprint": "ED:67:0B:D2:84:C2:93:6D:56:6F:A7:4D:5A:CC:B7:AF:8A:C0:1D:2A:7C:F3:4A:57:31:83:22:30:44:5F:63:9D",
+						"not_after":   "2029-05-14T10:03:26.000Z",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestCertificateExpiresWithin(t *testing.T) {
+	now := time.Date(2029, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	for notAfter, expected := range map[string]bool{
+		"2029-05-14T10:03:26.000Z": true,
+		"2029-07-14T10:03:26.000Z": false,
+		"2019-05-14T10:03:26Z":     true,
+	} {
+		expiring, err := security.CertificateExpiresWithin(notAfter, 30*24*time.Hour, now)
+		if err != nil {
+			t.Fatalf("failed to parse %s: %s", notAfter, err)
+		}
+		if expiring != expected {
+			t.Errorf("expected expiring %t for %s, got %t", expected, notAfter, expiring)
+		}
+	}
+
+	if _, err := security.CertificateExpiresWithin("never", time.Hour, now); err == nil {
+		t.Error("expected an error for an invalid date")
+	}
+}
+
+func TestNormalizeCertificateDate(t *testing.T) {
+	for date, expected := range map[string]string{
+		"2029-05-14T10:03:26.000Z":      "2029-05-14T10:03:26Z",
+		"2029-05-14T10:03:26Z":          "2029-05-14T10:03:26Z",
+		"2029-05-14T12:03:26.000+02:00": "2029-05-14T10:03:26Z",
+		"never":                         "never",
+	} {
+		if normalized := security.NormalizeCertificateDate(date); normalized != expected {
+			t.Errorf("expected %s for %s, got %s", expected, date, normalized)
+		}
+	}
+}
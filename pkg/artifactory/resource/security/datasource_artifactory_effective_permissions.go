@@ -0,0 +1,185 @@
+package security
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/artifactory/resource/repository"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"golang.org/x/exp/slices"
+)
+
+// EffectivePermissions response of the effective item permissions API, with the abbreviated actions of each principal
+type EffectivePermissions struct {
+	Uri        string `json:"uri"`
+	Principals struct {
+		Users  map[string][]string `json:"users"`
+		Groups map[string][]string `json:"groups"`
+	} `json:"principals"`
+}
+
+// abbreviated actions returned by the effective item permissions API
+var effectivePermissionActions = map[string]string{
+	"r":   PermRead,
+	"w":   PermWrite,
+	"n":   PermAnnotate,
+	"d":   PermDelete,
+	"m":   PermManage,
+	"mxm": PermManagedXrayMeta,
+	"x":   PermDistribute,
+}
+
+// ExpandEffectivePermissions converts the abbreviated actions to the permission names used by permission targets
+func ExpandEffectivePermissions(actions []string) []string {
+	permissions := make([]string, 0, len(actions))
+	for _, action := range actions {
+		if permission, ok := effectivePermissionActions[action]; ok {
+			permissions = append(permissions, permission)
+		} else {
+			permissions = append(permissions, action)
+		}
+	}
+	sort.Strings(permissions)
+	return permissions
+}
+
+// permissionTargetAppliesTo returns true when the repositories of a permission target include the repository,
+// explicitly or with one of the special `ANY` values
+func permissionTargetAppliesTo(repositories []string, repoKey, rclass string) bool {
+	return slices.Contains(repositories, repoKey) ||
+		slices.Contains(repositories, "ANY") ||
+		(rclass != "" && slices.Contains(repositories, "ANY "+strings.ToUpper(rclass)))
+}
+
+func DataSourceArtifactoryEffectivePermissions() *schema.Resource {
+	principalSchema := &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"permissions": {
+					Type:     schema.TypeSet,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Set:      schema.HashString,
+					Computed: true,
+				},
+			},
+		},
+	}
+
+	var packPrincipals = func(principals map[string][]string) []interface{} {
+		names := make([]string, 0, len(principals))
+		for name := range principals {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		packed := make([]interface{}, 0, len(names))
+		for _, name := range names {
+			packed = append(packed, map[string]interface{}{
+				"name":        name,
+				"permissions": schema.NewSet(schema.HashString, util.CastToInterfaceArr(ExpandEffectivePermissions(principals[name]))),
+			})
+		}
+		return packed
+	}
+
+	var findPermissionTargets = func(c *resty.Client, repoKey, rclass string) ([]string, error) {
+		var permissionTargets []struct {
+			Name string `json:"name"`
+		}
+		_, err := c.R().SetResult(&permissionTargets).Get(strings.TrimSuffix(permissionsEndPoint, "/"))
+		if err != nil {
+			return nil, err
+		}
+
+		var names []string
+		for _, permissionTarget := range permissionTargets {
+			target := new(PermissionTargetParams)
+			if _, err := c.R().SetResult(target).Get(permissionsEndPoint + permissionTarget.Name); err != nil {
+				return nil, err
+			}
+			if target.Repo != nil && permissionTargetAppliesTo(target.Repo.Repositories, repoKey, rclass) {
+				names = append(names, target.Name)
+			}
+		}
+		sort.Strings(names)
+
+		return names, nil
+	}
+
+	var dataSourceEffectivePermissionsRead = func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		c := m.(*resty.Client)
+		repoKey := d.Get("repository").(string)
+		path := strings.TrimPrefix(d.Get("path").(string), "/")
+
+		repo := struct {
+			Rclass string `json:"rclass"`
+		}{}
+		if _, err := c.R().SetResult(&repo).Get(repository.RepositoriesEndpoint + repoKey); err != nil {
+			return diag.Errorf("failed to read repository %s: %s", repoKey, err)
+		}
+
+		effectivePermissions := EffectivePermissions{}
+		_, err := c.R().
+			SetQueryParam("permissions", "").
+			SetResult(&effectivePermissions).
+			Get(fmt.Sprintf("artifactory/api/storage/%s/%s", repoKey, path))
+		if err != nil {
+			return diag.Errorf("failed to read effective permissions of %s/%s: %s", repoKey, path, err)
+		}
+
+		permissionTargets, err := findPermissionTargets(c, repoKey, repo.Rclass)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId(fmt.Sprintf("%s/%s", repoKey, path))
+
+		setValue := util.MkLens(d)
+		setValue("users", packPrincipals(effectivePermissions.Principals.Users))
+		setValue("groups", packPrincipals(effectivePermissions.Principals.Groups))
+		errors := setValue("permission_targets", permissionTargets)
+		if errors != nil && len(errors) > 0 {
+			return diag.Errorf("failed to pack effective permissions %q", errors)
+		}
+
+		return nil
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceEffectivePermissionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: repository.RepoKeyValidator,
+				Description:  "Key of the repository.",
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path of a folder or an artifact in the repository. Default to the root of the repository.",
+			},
+			"users":  principalSchema,
+			"groups": principalSchema,
+			"permission_targets": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "Names of the permission targets applying to the repository, explicitly or with `ANY`, `ANY LOCAL`, `ANY REMOTE` or `ANY DISTRIBUTION`.",
+			},
+		},
+		Description: "Provides the effective permissions of the users and groups on a repository, and the permission targets they come from.",
+	}
+}
@@ -0,0 +1,51 @@
+package security
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jfrog/terraform-provider-shared/packer"
+	"github.com/jfrog/terraform-provider-shared/predicate"
+)
+
+func DataSourceArtifactoryGroup() *schema.Resource {
+	dataSourceGroupSchema := computedSchema(groupSchema)
+	delete(dataSourceGroupSchema, "detach_all_users")
+	dataSourceGroupSchema["name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+		Description:  "Name of the group.",
+	}
+	dataSourceGroupSchema["users_names"].Description = "Names of the members of the group."
+
+	var dataSourceGroupRead = func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		name := d.Get("name").(string)
+
+		group := Group{}
+		resp, err := m.(*resty.Client).R().SetResult(&group).Get(fmt.Sprintf("%s%s?includeUsers=true", GroupsEndpoint, name))
+		if err != nil {
+			if resp != nil && (resp.StatusCode() == http.StatusBadRequest || resp.StatusCode() == http.StatusNotFound) {
+				return diag.Errorf("group %s not found", name)
+			}
+			return diag.FromErr(err)
+		}
+
+		d.SetId(group.Name)
+
+		pkr := packer.Universal(predicate.SchemaHasKey(dataSourceGroupSchema))
+
+		return diag.FromErr(pkr(&group, d))
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceGroupRead,
+		Schema:      dataSourceGroupSchema,
+		Description: "Provides the details of a group, including its members.",
+	}
+}
@@ -0,0 +1,66 @@
+package security
+
+import (
+	"context"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// computedSchema returns a copy of the resource schema with every attribute computed, to be used by a data source
+// reading the same object as the resource
+func computedSchema(resourceSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	dataSourceSchema := map[string]*schema.Schema{}
+
+	for key, s := range resourceSchema {
+		computed := &schema.Schema{
+			Type:        s.Type,
+			Computed:    true,
+			Set:         s.Set,
+			Description: s.Description,
+		}
+
+		switch elem := s.Elem.(type) {
+		case *schema.Resource:
+			computed.Elem = &schema.Resource{Schema: computedSchema(elem.Schema)}
+		case *schema.Schema:
+			computed.Elem = &schema.Schema{Type: elem.Type}
+		}
+
+		dataSourceSchema[key] = computed
+	}
+
+	return dataSourceSchema
+}
+
+func DataSourceArtifactoryPermissionTarget() *schema.Resource {
+	permissionTargetSchema := computedSchema(ResourceArtifactoryPermissionTarget().Schema)
+	permissionTargetSchema["name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+		Description:  "Name of the permission target.",
+	}
+
+	var dataSourcePermissionTargetRead = func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		name := d.Get("name").(string)
+
+		permissionTarget := new(PermissionTargetParams)
+		_, err := m.(*resty.Client).R().SetResult(permissionTarget).Get(permissionsEndPoint + name)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId(permissionTarget.Name)
+
+		return packPermissionTarget(permissionTarget, m.(*resty.Client), d)
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourcePermissionTargetRead,
+		Schema:      permissionTargetSchema,
+		Description: "Provides the repositories, builds and release bundles of a permission target, and the actions of its users and groups.",
+	}
+}
@@ -0,0 +1,79 @@
+package security_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/acctest"
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/artifactory/resource/security"
+	"github.com/jfrog/terraform-provider-shared/test"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccDataSourcePermissionTarget(t *testing.T) {
+	_, permFqrn, permName := test.MkNames("test-perm", "artifactory_permission_target")
+	_, _, repoName := test.MkNames("test-perm-repo", "artifactory_local_generic_repository")
+	dataSourceFqrn := "data.artifactory_permission_target." + permName
+	effectiveFqrn := "data.artifactory_effective_permissions." + repoName
+
+	config := util.ExecuteTemplate(permFqrn, `
+		resource "artifactory_local_generic_repository" "{{ .repo_name }}" {
+		  key = "{{ .repo_name }}"
+		}
+
+		resource "artifactory_permission_target" "{{ .perm_name }}" {
+		  name = "{{ .perm_name }}"
+		  repo {
+			includes_pattern = ["**"]
+			repositories     = [artifactory_local_generic_repository.{{ .repo_name }}.key]
+			actions {
+			  groups {
+				name        = "readers"
+				permissions = ["read", "write"]
+			  }
+			}
+		  }
+		}
+
+		data "artifactory_permission_target" "{{ .perm_name }}" {
+		  name = artifactory_permission_target.{{ .perm_name }}.name
+		}
+
+		data "artifactory_effective_permissions" "{{ .repo_name }}" {
+		  repository = artifactory_permission_target.{{ .perm_name }}.repo[0].repositories[0]
+		}`, map[string]string{
+		"perm_name": permName,
+		"repo_name": repoName,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testPermissionTargetCheckDestroy(permFqrn),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceFqrn, "name", permName),
+					resource.TestCheckResourceAttr(dataSourceFqrn, "repo.0.repositories.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceFqrn, "repo.0.actions.0.groups.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceFqrn, "repo.0.actions.0.groups.0.permissions.#", "2"),
+					resource.TestCheckTypeSetElemAttr(effectiveFqrn, "permission_targets.*", permName),
+					resource.TestCheckTypeSetElemNestedAttrs(effectiveFqrn, "groups.*", map[string]string{
+						"name": "readers",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestExpandEffectivePermissions(t *testing.T) {
+	actual := security.ExpandEffectivePermissions([]string{"r", "w", "n", "d", "m", "unknown"})
+	expected := []string{"annotate", "delete", "manage", "read", "unknown", "write"}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
@@ -2,6 +2,8 @@ package security
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -150,6 +152,11 @@ func ResourceArtifactoryAccessToken() *schema.Resource {
 				Computed:  true,
 				Sensitive: true,
 			},
+			"token_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the token in the Access service, used to detect if the token has been revoked or has expired.",
+			},
 		},
 
 		DeprecationMessage: "This resource is being deprecated and replaced by artifactory_scoped_token",
@@ -229,6 +236,16 @@ func resourceAccessTokenCreate(_ context.Context, d *schema.ResourceData, m inte
 		return diag.FromErr(err)
 	}
 
+	tokenId, err := TokenIdFromJwt(accessToken.AccessToken)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("token_id", tokenId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	refreshToken := ""
 	if refreshable {
 		refreshToken = accessToken.RefreshToken
@@ -241,12 +258,80 @@ func resourceAccessTokenCreate(_ context.Context, d *schema.ResourceData, m inte
 	return nil
 }
 
-func resourceAccessTokenRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
-	// Terraform requires that the read function is always implemented.
-	// However, Artifactory does not have an API to read a token.
+func resourceAccessTokenRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Artifactory does not retain the token itself, but the Access service keeps track of the tokens it issued.
+	// This is used to remove revoked and expired tokens from the state, so the next apply mints a new token.
+	tokenId := d.Get("token_id").(string)
+	if tokenId == "" {
+		// state created by an older version of the provider
+		var err error
+		tokenId, err = TokenIdFromJwt(d.Get("access_token").(string))
+		if err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("unable to find the ID of access token %s, skipping read: %s", d.Id(), err))
+			return nil
+		}
+		if err := d.Set("token_id", tokenId); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	type AccessTokenGet struct {
+		TokenId string `json:"token_id"`
+		Expiry  int64  `json:"expiry"`
+	}
+
+	accessToken := AccessTokenGet{}
+	resp, err := CheckAccessToken(tokenId, m.(*resty.Client).R().SetResult(&accessToken))
+	if err != nil {
+		if resp != nil {
+			if resp.StatusCode() == http.StatusNotFound {
+				tflog.Warn(ctx, fmt.Sprintf("access token %s has been revoked or has expired, removing from state", tokenId))
+				d.SetId("")
+				return nil
+			}
+			// Non-admin users can't read tokens from the Access service. Keep the token as we can't tell.
+			if resp.StatusCode() == http.StatusForbidden {
+				tflog.Debug(ctx, fmt.Sprintf("not allowed to read access token %s, skipping read", tokenId))
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	if accessToken.Expiry > 0 && time.Unix(accessToken.Expiry, 0).Before(time.Now()) {
+		tflog.Warn(ctx, fmt.Sprintf("access token %s has expired, removing from state", tokenId))
+		d.SetId("")
+	}
+
 	return nil
 }
 
+// TokenIdFromJwt returns the ID of the token (`jti` claim) from the payload of a JWT access token.
+// The signature is not verified, the token comes from Artifactory.
+func TokenIdFromJwt(token string) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", errors.New("access token is not a JWT")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return "", fmt.Errorf("unable to decode access token payload: %s", err)
+	}
+
+	claims := struct {
+		Jti string `json:"jti"`
+	}{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return "", fmt.Errorf("unable to parse access token payload: %s", err)
+	}
+	if claims.Jti == "" {
+		return "", errors.New("access token has no `jti` claim")
+	}
+
+	return claims.Jti, nil
+}
+
 func resourceAccessTokenDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	// Artifactory only allows you to revoke a token if the there is no expiry.
 	// Otherwise, Artifactory will ensure the token is revoked at the expiry time.
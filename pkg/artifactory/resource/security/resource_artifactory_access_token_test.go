@@ -428,6 +428,42 @@ func TestAccAccessTokenNonExpiringToken(t *testing.T) {
 	})
 }
 
+func TestAccAccessTokenRevokedTokenIsRecreated(t *testing.T) {
+	fqrn := "artifactory_access_token.foobar"
+	var tokenId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckAccessTokenDestroy(t, fqrn),
+		Steps: []resource.TestStep{
+			{
+				Config: nonExpiringToken,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(fqrn, "token_id"),
+					func(s *terraform.State) error {
+						tokenId = s.RootModule().Resources[fqrn].Primary.Attributes["token_id"]
+						return nil
+					},
+				),
+			},
+			{
+				PreConfig: func() {
+					_, err := acctest.GetTestResty(t).R().
+						SetPathParam("id", tokenId).
+						Delete("access/api/v1/tokens/{id}")
+					if err != nil {
+						t.Fatalf("failed to revoke token %s: %s", tokenId, err)
+					}
+				},
+				Config:             nonExpiringToken,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func testAccCheckAccessTokenDestroy(t *testing.T, id string) func(*terraform.State) error {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[id]
@@ -488,3 +524,22 @@ func TestTokenOptsToValuesNonExpiring(t *testing.T) {
 		t.Error("`expires_in` not correctly set when creating non-expiring tokens")
 	}
 }
+
+func TestTokenIdFromJwt(t *testing.T) {
+	// header and payload of a token issued by Artifactory, signature is not verified
+	const token = "eyJ2ZXIiOiIyIiwidHlwIjoiSldUIiwiYWxnIjoiUlMyNTYifQ" +
+		".eyJzdWIiOiJqZmFjQDAxL3VzZXJzL2FkbWluIiwic2NwIjoiYXBwbGllZC1wZXJtaXNzaW9ucy9hZG1pbiIsImp0aSI6IjhmYmM0NTdjLTJmN2EtNGRlZS05ODk3LTBkYTJmM2Q1MWRjNCJ9" +
+		".c2lnbmF0dXJl"
+
+	tokenId, err := security.TokenIdFromJwt(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tokenId != "8fbc457c-2f7a-4dee-9897-0da2f3d51dc4" {
+		t.Errorf("expected token ID 8fbc457c-2f7a-4dee-9897-0da2f3d51dc4, got %s", tokenId)
+	}
+
+	if _, err := security.TokenIdFromJwt("not-a-jwt"); err == nil {
+		t.Error("expected an error for a token which is not a JWT")
+	}
+}
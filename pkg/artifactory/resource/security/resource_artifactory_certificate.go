@@ -2,6 +2,7 @@ package security
 
 import (
 	"context"
+	"crypto"
 	"crypto/sha256"
 	"crypto/x509"
 	"encoding/hex"
@@ -10,6 +11,7 @@ import (
 	"io/ioutil"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -103,6 +105,31 @@ func ResourceArtifactoryCertificate() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"not_before": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Start of the validity period of the certificate, in RFC 3339 format.",
+			},
+			"not_after": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "End of the validity period of the certificate, in RFC 3339 format.",
+			},
+			"issuer": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Distinguished name of the issuer of the certificate.",
+			},
+			"subject": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Distinguished name of the subject of the certificate.",
+			},
+			"serial": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Serial number of the certificate, as colon separated hex bytes.",
+			},
 		},
 
 		CustomizeDiff: calculateFingerprint,
@@ -115,15 +142,50 @@ func calculateFingerprint(_ context.Context, d *schema.ResourceDiff, _ interface
 	if err != nil {
 		return err
 	}
+	if err := ValidateCertificateBundle(content); err != nil {
+		return err
+	}
 	if d.Get("fingerprint").(string) != fingerprint {
-		if err = d.SetNewComputed("fingerprint"); err != nil {
-			fmt.Println(err)
-			return err
+		for _, key := range []string{"fingerprint", "not_before", "not_after", "issuer", "subject", "serial"} {
+			if err = d.SetNewComputed(key); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
 }
 
+// certificateValidity attributes of the certificate that are not returned by the API, or only partially
+func certificateValidity(cert *x509.Certificate) map[string]string {
+	return map[string]string{
+		"not_before": cert.NotBefore.UTC().Format(time.RFC3339),
+		"not_after":  cert.NotAfter.UTC().Format(time.RFC3339),
+		"issuer":     cert.Issuer.String(),
+		"subject":    cert.Subject.String(),
+		"serial":     formatFingerPrint(cert.SerialNumber.Bytes()),
+	}
+}
+
+// NormalizeCertificateDate returns the date returned by the API, e.g. 2023-01-02T15:04:26.000Z, in the RFC3339 format
+// of the dates parsed from the certificate content. A date that can't be parsed is returned unchanged.
+func NormalizeCertificateDate(date string) string {
+	parsed, err := time.Parse(time.RFC3339, date)
+	if err != nil {
+		return date
+	}
+	return parsed.UTC().Format(time.RFC3339)
+}
+
+// CertificateExpiresWithin returns true when the certificate expiring at notAfter expires before now + within.
+// Certificates that already expired are also expiring.
+func CertificateExpiresWithin(notAfter string, within time.Duration, now time.Time) (bool, error) {
+	expiry, err := time.Parse(time.RFC3339, notAfter)
+	if err != nil {
+		return false, err
+	}
+	return expiry.Before(now.Add(within)), nil
+}
+
 func formatFingerPrint(f []byte) string {
 	buf := make([]byte, 0, 3*len(f))
 	x := buf[1*len(f) : 3*len(f)]
@@ -153,6 +215,65 @@ func extractCertificate(pemData string) (*x509.Certificate, error) {
 	return nil, fmt.Errorf("no certificate in PEM data")
 }
 
+// ValidateCertificateBundle checks the PEM data holds exactly one private key, matching the public key of the first
+// (leaf) certificate, and that each following certificate signed the previous one
+func ValidateCertificateBundle(pemData string) error {
+	var certificates []*x509.Certificate
+	var privateKeys []crypto.PrivateKey
+
+	for block, rest := pem.Decode([]byte(pemData)); block != nil; block, rest = pem.Decode(rest) {
+		switch block.Type {
+		case "CERTIFICATE":
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return err
+			}
+			certificates = append(certificates, cert)
+		case "PRIVATE KEY", "RSA PRIVATE KEY", "EC PRIVATE KEY":
+			key, err := parsePrivateKey(block)
+			if err != nil {
+				return fmt.Errorf("unable to parse the private key: %s", err)
+			}
+			privateKeys = append(privateKeys, key)
+		}
+	}
+
+	if len(certificates) == 0 {
+		return fmt.Errorf("no certificate in PEM data")
+	}
+	if len(privateKeys) != 1 {
+		return fmt.Errorf("the PEM data must hold exactly one private key, found %d", len(privateKeys))
+	}
+
+	signer, ok := privateKeys[0].(crypto.Signer)
+	if !ok {
+		return fmt.Errorf("unsupported private key type %T", privateKeys[0])
+	}
+	publicKey, ok := signer.Public().(interface{ Equal(crypto.PublicKey) bool })
+	if !ok || !publicKey.Equal(certificates[0].PublicKey) {
+		return fmt.Errorf("the private key doesn't match the public key of the certificate %s", certificates[0].Subject)
+	}
+
+	for i := 1; i < len(certificates); i++ {
+		if err := certificates[i-1].CheckSignatureFrom(certificates[i]); err != nil {
+			return fmt.Errorf("the certificate %s isn't signed by the next certificate %s of the chain: %s", certificates[i-1].Subject, certificates[i].Subject, err)
+		}
+	}
+
+	return nil
+}
+
+func parsePrivateKey(block *pem.Block) (crypto.PrivateKey, error) {
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	default:
+		return x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+}
+
 func calculateFingerPrint(pemData string) (string, error) {
 	cert, err := extractCertificate(pemData)
 	if err != nil {
@@ -204,6 +325,24 @@ func resourceCertificateRead(_ context.Context, d *schema.ResourceData, m interf
 		setValue("issued_to", (*cert).IssuedTo)
 		errors := setValue("valid_until", (*cert).ValidUntil)
 
+		// the content isn't known after an import, the API only returns the dates and the common names, which are
+		// already in issued_by and issued_to
+		validity := map[string]string{
+			"not_before": NormalizeCertificateDate((*cert).IssuedOn),
+			"not_after":  NormalizeCertificateDate((*cert).ValidUntil),
+			"issuer":     "",
+			"subject":    "",
+			"serial":     "",
+		}
+		if content, err := getContentFromData(d); err == nil {
+			if parsed, err := extractCertificate(content); err == nil {
+				validity = certificateValidity(parsed)
+			}
+		}
+		for key, value := range validity {
+			errors = setValue(key, value)
+		}
+
 		if errors != nil && len(errors) > 0 {
 			return diag.Errorf("failed to pack certificate %q", errors)
 		}
@@ -1,10 +1,18 @@
 package security_test
 
 import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"fmt"
+	"math/big"
+	"os"
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -76,6 +84,11 @@ func TestAccCertWithFile(t *testing.T) {
 					resource.TestCheckResourceAttr(fqrn, "issued_on", "2019-05-17T10:03:26.000Z"),
 					resource.TestCheckResourceAttr(fqrn, "issued_to", "Unknown"),
 					resource.TestCheckResourceAttr(fqrn, "valid_until", "2029-05-14T10:03:26.000Z"),
+					resource.TestCheckResourceAttr(fqrn, "not_before", "2019-05-17T10:03:26Z"),
+					resource.TestCheckResourceAttr(fqrn, "not_after", "2029-05-14T10:03:26Z"),
+					resource.TestCheckResourceAttr(fqrn, "issuer", "O=Default Company Ltd,L=Default City,C=XX"),
+					resource.TestCheckResourceAttr(fqrn, "subject", "O=Default Company Ltd,L=Default City,C=XX"),
+					resource.TestCheckResourceAttr(fqrn, "serial", "B4:43:9E:0D:EB:19:E4:2F"),
 				),
 			},
 		},
@@ -141,6 +154,11 @@ func TestAccCertificate_full(t *testing.T) {
 					resource.TestCheckResourceAttr(fqrn, "issued_on", "2019-05-17T10:03:26.000Z"),
 					resource.TestCheckResourceAttr(fqrn, "issued_to", "Unknown"),
 					resource.TestCheckResourceAttr(fqrn, "valid_until", "2029-05-14T10:03:26.000Z"),
+					resource.TestCheckResourceAttr(fqrn, "not_before", "2019-05-17T10:03:26Z"),
+					resource.TestCheckResourceAttr(fqrn, "not_after", "2029-05-14T10:03:26Z"),
+					resource.TestCheckResourceAttr(fqrn, "issuer", "O=Default Company Ltd,L=Default City,C=XX"),
+					resource.TestCheckResourceAttr(fqrn, "subject", "O=Default Company Ltd,L=Default City,C=XX"),
+					resource.TestCheckResourceAttr(fqrn, "serial", "B4:43:9E:0D:EB:19:E4:2F"),
 				),
 			},
 		},
@@ -167,3 +185,66 @@ func testAccCheckCertificateDestroy(id string) func(*terraform.State) error {
 		return nil
 	}
 }
+
+func TestValidateCertificateBundle(t *testing.T) {
+	mkKey := func() (*rsa.PrivateKey, string) {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return key, string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+	}
+	mkCert := func(name string, key *rsa.PrivateKey, parent *x509.Certificate, parentKey *rsa.PrivateKey) (*x509.Certificate, string) {
+		template := &x509.Certificate{
+			SerialNumber:          big.NewInt(time.Now().UnixNano()),
+			Subject:               pkix.Name{CommonName: name},
+			NotBefore:             time.Now(),
+			NotAfter:              time.Now().Add(time.Hour),
+			IsCA:                  parent == nil,
+			BasicConstraintsValid: true,
+			KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		}
+		if parent == nil {
+			parent, parentKey = template, key
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cert, _ := x509.ParseCertificate(der)
+		return cert, string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	}
+
+	caKey, _ := mkKey()
+	ca, caPem := mkCert("ca", caKey, nil, nil)
+	otherCaKey, _ := mkKey()
+	_, otherCaPem := mkCert("other-ca", otherCaKey, nil, nil)
+	leafKey, leafKeyPem := mkKey()
+	_, leafPem := mkCert("leaf", leafKey, ca, caKey)
+	_, otherKeyPem := mkKey()
+
+	sample, err := os.ReadFile("../../../../samples/cert.pem")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		pem   string
+		error string
+	}{
+		"sample":       {string(sample), ""},
+		"chain":        {leafPem + caPem + leafKeyPem, ""},
+		"no key":       {leafPem + caPem, "exactly one private key, found 0"},
+		"two keys":     {leafPem + leafKeyPem + otherKeyPem, "exactly one private key, found 2"},
+		"mismatch key": {leafPem + otherKeyPem, "doesn't match the public key"},
+		"broken chain": {leafPem + otherCaPem + leafKeyPem, "isn't signed by the next certificate"},
+	} {
+		err := security.ValidateCertificateBundle(tc.pem)
+		if tc.error == "" && err != nil {
+			t.Errorf("%s: unexpected error %s", name, err)
+		}
+		if tc.error != "" && (err == nil || !strings.Contains(err.Error(), tc.error)) {
+			t.Errorf("%s: expected error %q, got %v", name, tc.error, err)
+		}
+	}
+}
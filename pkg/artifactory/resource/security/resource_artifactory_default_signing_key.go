@@ -0,0 +1,121 @@
+package security
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	gpgKeyEndpoint           = "artifactory/api/gpg/key"
+	gpgPublicKeyEndpoint     = "artifactory/api/gpg/key/public"
+	gpgPrivateKeyEndpoint    = "artifactory/api/gpg/key/private"
+	gpgPassphraseKeyEndpoint = "artifactory/api/gpg/key/passphrase"
+)
+
+func ResourceArtifactoryDefaultSigningKey() *schema.Resource {
+	var resourceDefaultSigningKeyRead = func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		resp, err := m.(*resty.Client).R().Get(gpgPublicKeyEndpoint)
+		if err != nil {
+			if resp != nil && resp.StatusCode() == http.StatusNotFound {
+				d.SetId("")
+				return nil
+			}
+			return diag.FromErr(err)
+		}
+
+		// the private key and the passphrase are not returned by the API
+		if err := d.Set("public_key", resp.String()); err != nil {
+			return diag.FromErr(err)
+		}
+
+		return nil
+	}
+
+	// resourceDefaultSigningKeyUpdate uploads the whole key pair, the public and private keys must match
+	var resourceDefaultSigningKeyUpdate = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		c := m.(*resty.Client)
+
+		// the public key is uploaded first
+		for _, key := range []struct{ attribute, endpoint string }{
+			{"public_key", gpgPublicKeyEndpoint},
+			{"private_key", gpgPrivateKeyEndpoint},
+		} {
+			_, err := c.R().
+				SetHeader("Content-Type", "text/plain").
+				SetBody(d.Get(key.attribute).(string)).
+				Put(key.endpoint)
+			if err != nil {
+				return diag.Errorf("failed to upload the %s of the default signing key: %s", key.attribute, err)
+			}
+		}
+
+		if passphrase := d.Get("passphrase").(string); passphrase != "" {
+			_, err := c.R().
+				SetHeader("X-GPG-PASSPHRASE", passphrase).
+				Put(gpgPassphraseKeyEndpoint)
+			if err != nil {
+				return diag.Errorf("failed to set the passphrase of the default signing key: %s", err)
+			}
+		}
+
+		// there is a single default signing key, using same id
+		d.SetId("default_signing_key")
+		return resourceDefaultSigningKeyRead(ctx, d, m)
+	}
+
+	var resourceDefaultSigningKeyDelete = func(_ context.Context, _ *schema.ResourceData, m interface{}) diag.Diagnostics {
+		resp, err := m.(*resty.Client).R().Delete(gpgKeyEndpoint)
+		if err != nil && (resp == nil || resp.StatusCode() != http.StatusNotFound) {
+			return diag.FromErr(err)
+		}
+		return nil
+	}
+
+	var sameKey = func(_, old, new string, _ *schema.ResourceData) bool {
+		return strings.TrimSpace(stripTabs(old)) == strings.TrimSpace(stripTabs(new))
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceDefaultSigningKeyUpdate,
+		ReadContext:   resourceDefaultSigningKeyRead,
+		UpdateContext: resourceDefaultSigningKeyUpdate,
+		DeleteContext: resourceDefaultSigningKeyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"public_key": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: sameKey,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(regexp.MustCompile("BEGIN PGP PUBLIC KEY BLOCK"), "must be an ASCII armored GPG public key")),
+				Description:      "ASCII armored GPG public key of the default signing key.",
+			},
+			"private_key": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Sensitive:        true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(regexp.MustCompile("BEGIN PGP PRIVATE KEY BLOCK"), "must be an ASCII armored GPG private key")),
+				Description:      "ASCII armored GPG private key of the default signing key.",
+			},
+			"passphrase": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Passphrase of the private key.",
+			},
+		},
+
+		Description: "Manages the system-wide default GPG signing key of Artifactory, used to sign the metadata of the " +
+			"debian, rpm and other repositories without a signing keypair of their own.",
+	}
+}
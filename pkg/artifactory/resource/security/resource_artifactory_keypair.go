@@ -4,10 +4,20 @@ import (
 	"github.com/jfrog/terraform-provider-shared/packer"
 	"github.com/jfrog/terraform-provider-shared/predicate"
 
+	"bytes"
 	"context"
+	"crypto/rand"
 	"crypto/rsa"
+	"crypto/sha256"
 	"crypto/x509"
+	"encoding/hex"
 	"encoding/pem"
+	"fmt"
+	"net/mail"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
 	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -15,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/jfrog/terraform-provider-shared/client"
 	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/youmark/pkcs8"
 	"strings"
 )
 
@@ -41,8 +52,9 @@ var keyPairSchema = map[string]*schema.Schema{
 		Type: schema.TypeString,
 		// working sample PGP key is checked in but not tested
 		ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"RSA", "GPG"}, false)),
-		Required:         true,
-		Description:      "Key Pair type. Supported types - GPG and RSA.",
+		Optional:         true,
+		Computed:         true,
+		Description:      "Key Pair type. Supported types - GPG and RSA. Required unless the key pair is generated, defaults to the generated algorithm.",
 		ForceNew:         true,
 	},
 	"alias": {
@@ -54,26 +66,70 @@ var keyPairSchema = map[string]*schema.Schema{
 	"private_key": {
 		Type:             schema.TypeString,
 		Sensitive:        true,
-		Required:         true,
+		Optional:         true,
 		StateFunc:        stripTabs,
 		ValidateDiagFunc: validatePrivateKey,
-		Description:      "Private key. PEM format will be validated.",
+		ExactlyOneOf:     []string{"private_key", "generate"},
+		RequiredWith:     []string{"public_key"},
+		Description:      "Private key. PEM format will be validated. Conflicts with `generate`.",
 		ForceNew:         true,
 	},
 	"passphrase": {
 		Type:             schema.TypeString,
 		Optional:         true,
 		DiffSuppressFunc: ignoreEmpty,
-		Description:      "Passphrase will be used to decrypt the private key. Validated server side",
+		Sensitive:        true,
+		Description:      "Passphrase will be used to decrypt the private key. Validated server side. A generated private key is encrypted with it.",
 		ForceNew:         true,
 	},
 	"public_key": {
 		Type:             schema.TypeString,
-		Required:         true,
+		Optional:         true,
+		Computed:         true,
 		StateFunc:        stripTabs,
 		ValidateDiagFunc: validatePublicKey,
+		ConflictsWith:    []string{"generate"},
 		ForceNew:         true,
-		Description:      "Public key. PEM format will be validated.",
+		Description:      "Public key. PEM format will be validated. Computed when the key pair is generated.",
+	},
+	"generate": {
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"algorithm": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ForceNew:         true,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"RSA", "GPG"}, false)),
+					Description:      "Algorithm of the generated key pair: RSA or GPG.",
+				},
+				"key_size": {
+					Type:             schema.TypeInt,
+					Optional:         true,
+					ForceNew:         true,
+					Default:          2048,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.IntInSlice([]int{2048, 3072, 4096})),
+					Description:      "Size in bits of the generated RSA key: 2048, 3072 or 4096. Default value is 2048.",
+				},
+				"gpg_identity": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ForceNew:         true,
+					ValidateDiagFunc: validation.ToDiagFunc(validateGpgIdentity),
+					Description:      "User ID of the generated GPG key, e.g. 'Release Signing <release@example.com>'. Required for GPG.",
+				},
+			},
+		},
+		Description: "Generate the key pair in the provider instead of passing `private_key` and `public_key`. " +
+			"The generated private key is uploaded to Artifactory and never stored in the state.",
+	},
+	"fingerprint": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Fingerprint of the public key: the OpenPGP fingerprint of a GPG key, the SHA-256 digest of an RSA key.",
 	},
 	"unavailable": {
 		Type:        schema.TypeBool,
@@ -97,10 +153,141 @@ func ResourceArtifactoryKeyPair() *schema.Resource {
 			"and REST API. The JFrog Platform supports managing multiple pairs of GPG signing keys to sign packages for" +
 			" authentication of several package types such as Debian, Opkg, and RPM through the Keys Management UI and REST API.",
 
+		CustomizeDiff: customizeKeyPairDiff,
+
 		Schema: keyPairSchema,
 	}
 }
 
+func customizeKeyPairDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	generate := diff.Get("generate").([]interface{})
+	if len(generate) == 0 || generate[0] == nil {
+		if diff.NewValueKnown("pair_type") && diff.Get("pair_type").(string) == "" {
+			return fmt.Errorf("pair_type is required when the key pair isn't generated")
+		}
+		return nil
+	}
+
+	settings := generate[0].(map[string]interface{})
+	algorithm := settings["algorithm"].(string)
+	if pairType := diff.Get("pair_type").(string); pairType != "" && pairType != algorithm {
+		return fmt.Errorf("pair_type %s doesn't match the generated algorithm %s", pairType, algorithm)
+	}
+	if algorithm == "GPG" {
+		if settings["gpg_identity"].(string) == "" {
+			return fmt.Errorf("gpg_identity is required to generate a GPG key pair")
+		}
+	}
+
+	if diff.Id() == "" {
+		return diff.SetNew("pair_type", algorithm)
+	}
+	return nil
+}
+
+func validateGpgIdentity(value interface{}, key string) ([]string, []error) {
+	if _, err := mail.ParseAddress(value.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%s must be in the format 'Name <email>': %s", key, err)}
+	}
+	return nil, nil
+}
+
+// GenerateKeyPair generates an RSA or GPG key pair and returns the PEM or armored private and public keys.
+// The private key is encrypted when a passphrase is given: the GPG primary key and subkeys with the OpenPGP S2K
+// encryption, the RSA key as an encrypted PKCS#8 key.
+func GenerateKeyPair(algorithm string, keySize int, gpgIdentity, passphrase string) (string, string, error) {
+	if algorithm == "GPG" {
+		identity, err := mail.ParseAddress(gpgIdentity)
+		if err != nil {
+			return "", "", err
+		}
+		entity, err := openpgp.NewEntity(identity.Name, "", identity.Address, &packet.Config{
+			Algorithm: packet.PubKeyAlgoRSA,
+			RSABits:   keySize,
+		})
+		if err != nil {
+			return "", "", err
+		}
+
+		// the identities are signed by NewEntity, so the keys are serialized without signing them again once encrypted
+		if passphrase != "" {
+			if err := entity.PrivateKey.Encrypt([]byte(passphrase)); err != nil {
+				return "", "", err
+			}
+			for _, subkey := range entity.Subkeys {
+				if err := subkey.PrivateKey.Encrypt([]byte(passphrase)); err != nil {
+					return "", "", err
+				}
+			}
+		}
+
+		var private, public bytes.Buffer
+		w, err := armor.Encode(&private, openpgp.PrivateKeyType, nil)
+		if err != nil {
+			return "", "", err
+		}
+		if err := entity.SerializePrivateWithoutSigning(w, nil); err != nil {
+			return "", "", err
+		}
+		w.Close()
+
+		w, err = armor.Encode(&public, openpgp.PublicKeyType, nil)
+		if err != nil {
+			return "", "", err
+		}
+		if err := entity.Serialize(w); err != nil {
+			return "", "", err
+		}
+		w.Close()
+
+		return private.String(), public.String(), nil
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, keySize)
+	if err != nil {
+		return "", "", err
+	}
+
+	privateBlock := &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}
+	if passphrase != "" {
+		encrypted, err := pkcs8.MarshalPrivateKey(key, []byte(passphrase), nil)
+		if err != nil {
+			return "", "", err
+		}
+		privateBlock = &pem.Block{Type: "ENCRYPTED PRIVATE KEY", Bytes: encrypted}
+	}
+
+	publicBytes, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		return "", "", err
+	}
+
+	return string(pem.EncodeToMemory(privateBlock)), string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicBytes})), nil
+}
+
+// KeyPairFingerprint returns the OpenPGP fingerprint of an armored GPG public key, or the SHA-256 digest of a PEM
+// public key
+func KeyPairFingerprint(publicKey string) (string, error) {
+	stripped := stripTabs(publicKey)
+	if strings.Contains(stripped, "BEGIN PGP PUBLIC KEY BLOCK") {
+		entities, err := openpgp.ReadArmoredKeyRing(strings.NewReader(stripped))
+		if err != nil {
+			return "", err
+		}
+		if len(entities) == 0 {
+			return "", fmt.Errorf("no key found in the GPG public key")
+		}
+		return strings.ToUpper(hex.EncodeToString(entities[0].PrimaryKey.Fingerprint[:])), nil
+	}
+
+	pubPem, _ := pem.Decode([]byte(stripped))
+	if pubPem == nil {
+		return "", fmt.Errorf("public key not in pem format")
+	}
+	digest := sha256.Sum256(pubPem.Bytes)
+	return hex.EncodeToString(digest[:]), nil
+}
+
 func validatePrivateKey(value interface{}, _ cty.Path) diag.Diagnostics {
 	stripped := strings.ReplaceAll(value.(string), "\t", "")
 	var err error
@@ -187,6 +374,7 @@ func unpackKeyPair(s *schema.ResourceData) (interface{}, string, error) {
 		PairType:    d.GetString("pair_type", false),
 		Alias:       d.GetString("alias", false),
 		PrivateKey:  strings.ReplaceAll(d.GetString("private_key", false), "\t", ""),
+		Passphrase:  d.GetString("passphrase", false),
 		PublicKey:   strings.ReplaceAll(d.GetString("public_key", false), "\t", ""),
 		Unavailable: d.GetBool("unavailable", false),
 	}
@@ -195,14 +383,39 @@ func unpackKeyPair(s *schema.ResourceData) (interface{}, string, error) {
 
 var keyPairPacker = packer.Universal(
 	predicate.All(
-		predicate.Ignore("private_key"),
+		predicate.Ignore("private_key", "passphrase"),
 		predicate.SchemaHasKey(keyPairSchema),
 	),
 )
 
+func packKeyPair(keyPair KeyPairPayLoad, d *schema.ResourceData) error {
+	if err := keyPairPacker(keyPair, d); err != nil {
+		return err
+	}
+	// the fingerprint is informative, a key that can't be parsed doesn't fail the read
+	fingerprint, _ := KeyPairFingerprint(keyPair.PublicKey)
+	return d.Set("fingerprint", fingerprint)
+}
+
 func createKeyPair(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	keyPair, key, _ := unpackKeyPair(d)
 
+	if generate := d.Get("generate").([]interface{}); len(generate) > 0 && generate[0] != nil {
+		settings := generate[0].(map[string]interface{})
+		privateKey, publicKey, err := GenerateKeyPair(
+			settings["algorithm"].(string),
+			settings["key_size"].(int),
+			settings["gpg_identity"].(string),
+			keyPair.(*KeyPairPayLoad).Passphrase,
+		)
+		if err != nil {
+			return diag.Errorf("failed to generate key pair: %s", err)
+		}
+		keyPair.(*KeyPairPayLoad).PairType = settings["algorithm"].(string)
+		keyPair.(*KeyPairPayLoad).PrivateKey = privateKey
+		keyPair.(*KeyPairPayLoad).PublicKey = publicKey
+	}
+
 	_, err := m.(*resty.Client).R().
 		AddRetryCondition(client.RetryOnMergeError).
 		SetBody(keyPair).
@@ -210,7 +423,7 @@ func createKeyPair(_ context.Context, d *schema.ResourceData, m interface{}) dia
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	err = keyPairPacker(*keyPair.(*KeyPairPayLoad), d)
+	err = packKeyPair(*keyPair.(*KeyPairPayLoad), d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -225,7 +438,7 @@ func readKeyPair(_ context.Context, d *schema.ResourceData, meta interface{}) di
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	err = keyPairPacker(data, d)
+	err = packKeyPair(data, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
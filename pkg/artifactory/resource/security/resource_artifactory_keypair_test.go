@@ -1,14 +1,18 @@
 package security_test
 
 import (
+	"encoding/pem"
 	"fmt"
 	"github.com/jfrog/terraform-provider-shared/test"
 	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/ProtonMail/go-crypto/openpgp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/acctest"
 	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/artifactory/resource/security"
+	"github.com/youmark/pkcs8"
 )
 
 func TestAccKeyPairFailPrivateCertCheck(t *testing.T) {
@@ -298,3 +302,89 @@ func TestAccKeyPairGPG(t *testing.T) {
 		},
 	})
 }
+
+func TestAccKeyPairGenerate(t *testing.T) {
+	for _, algorithm := range []string{"RSA", "GPG"} {
+		t.Run(algorithm, func(t *testing.T) {
+			id, fqrn, name := test.MkNames("mykp", "artifactory_keypair")
+			keyGenerate := fmt.Sprintf(`
+				resource "artifactory_keypair" "%s" {
+					pair_name  = "%s"
+					alias      = "foo-alias%d"
+					passphrase = "secret"
+
+					generate {
+						algorithm    = "%s"
+						key_size     = 2048
+						gpg_identity = "Release Signing <release@example.com>"
+					}
+				}
+			`, name, name, id, algorithm)
+
+			resource.Test(t, resource.TestCase{
+				PreCheck:          func() { acctest.PreCheck(t) },
+				ProviderFactories: acctest.ProviderFactories,
+				CheckDestroy:      acctest.VerifyDeleted(fqrn, security.VerifyKeyPair),
+				Steps: []resource.TestStep{
+					{
+						Config: keyGenerate,
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(fqrn, "pair_type", algorithm),
+							resource.TestCheckResourceAttr(fqrn, "private_key", ""),
+							resource.TestMatchResourceAttr(fqrn, "public_key", regexp.MustCompile("PUBLIC KEY")),
+							resource.TestMatchResourceAttr(fqrn, "fingerprint", regexp.MustCompile("^[0-9a-fA-F]{40,64}$")),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestGenerateKeyPair(t *testing.T) {
+	privateKey, publicKey, err := security.GenerateKeyPair("RSA", 2048, "", "secret")
+	if err != nil {
+		t.Fatalf("failed to generate RSA key pair: %s", err)
+	}
+	block, _ := pem.Decode([]byte(privateKey))
+	if block == nil || block.Type != "ENCRYPTED PRIVATE KEY" {
+		t.Fatalf("expected an encrypted PKCS#8 RSA private key, got %q", privateKey)
+	}
+	if _, _, err := pkcs8.ParsePrivateKey(block.Bytes, []byte("secret")); err != nil {
+		t.Errorf("failed to decrypt the RSA private key with the passphrase: %s", err)
+	}
+	if fingerprint, err := security.KeyPairFingerprint(publicKey); err != nil || len(fingerprint) != 64 {
+		t.Errorf("unexpected RSA fingerprint %q: %v", fingerprint, err)
+	}
+
+	privateKey, publicKey, err = security.GenerateKeyPair("GPG", 2048, "Release Signing <release@example.com>", "")
+	if err != nil {
+		t.Fatalf("failed to generate GPG key pair: %s", err)
+	}
+	if !strings.Contains(privateKey, "BEGIN PGP PRIVATE KEY BLOCK") || !strings.Contains(publicKey, "BEGIN PGP PUBLIC KEY BLOCK") {
+		t.Errorf("expected armored GPG keys, got %q and %q", privateKey, publicKey)
+	}
+	if fingerprint, err := security.KeyPairFingerprint(publicKey); err != nil || len(fingerprint) != 40 {
+		t.Errorf("unexpected GPG fingerprint %q: %v", fingerprint, err)
+	}
+
+	privateKey, _, err = security.GenerateKeyPair("GPG", 2048, "Release Signing <release@example.com>", "secret")
+	if err != nil {
+		t.Fatalf("failed to generate encrypted GPG key pair: %s", err)
+	}
+	entities, err := openpgp.ReadArmoredKeyRing(strings.NewReader(privateKey))
+	if err != nil || len(entities) != 1 {
+		t.Fatalf("failed to read the GPG private key: %v", err)
+	}
+	entity := entities[0]
+	if !entity.PrivateKey.Encrypted || len(entity.Subkeys) == 0 || !entity.Subkeys[0].PrivateKey.Encrypted {
+		t.Errorf("expected the GPG primary key and subkeys to be encrypted")
+	}
+	if err := entity.DecryptPrivateKeys([]byte("secret")); err != nil {
+		t.Errorf("failed to decrypt the GPG private keys with the passphrase: %s", err)
+	}
+
+	if _, _, err := security.GenerateKeyPair("GPG", 2048, "not an identity", ""); err == nil {
+		t.Error("expected an error for an invalid GPG identity")
+	}
+}
@@ -2,7 +2,9 @@ package security
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"sort"
 	"strings"
 
 	"github.com/go-resty/resty/v2"
@@ -11,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/artifactory/resource/repository"
 	"github.com/jfrog/terraform-provider-shared/util"
+	"golang.org/x/exp/slices"
 )
 
 const permissionsEndPoint = "artifactory/api/v2/security/permissions/"
@@ -47,6 +50,66 @@ type Actions struct {
 	Groups map[string][]string `json:"groups,omitempty"`
 }
 
+// Special values of the repositories of a permission target, matching all the repositories, or all the repositories of
+// a class, including the ones created after the permission target
+var anyRepositories = []string{"ANY", "ANY LOCAL", "ANY REMOTE", "ANY DISTRIBUTION"}
+
+// ValidatePermissionTargetRepository checks the repository is one of the special `ANY` values or a repository key.
+// Misspelled `ANY` values are rejected, as Artifactory would accept them as a repository key and grant nothing.
+func ValidatePermissionTargetRepository(repo string) error {
+	upper := strings.ToUpper(repo)
+	if upper == "ANY" || strings.HasPrefix(upper, "ANY ") {
+		for _, any := range anyRepositories {
+			if repo == any {
+				return nil
+			}
+		}
+		return fmt.Errorf("invalid repository %q, expected one of %s or a repository key", repo, strings.Join(anyRepositories, ", "))
+	}
+
+	if strings.TrimSpace(repo) == "" || strings.ContainsAny(repo, " \t/") {
+		return fmt.Errorf("invalid repository key %q", repo)
+	}
+
+	return nil
+}
+
+// ValidateAntPattern checks the syntax of an ant-style include or exclude pattern, e.g. `org/**/*.jar`
+func ValidateAntPattern(pattern string) error {
+	if strings.TrimSpace(pattern) != pattern {
+		return fmt.Errorf("pattern %q must not start or end with spaces", pattern)
+	}
+	if strings.Contains(pattern, "\\") {
+		return fmt.Errorf("pattern %q must use '/' as path separator", pattern)
+	}
+	if strings.Contains(pattern, ",") {
+		return fmt.Errorf("pattern %q must not contain ',', use one element per pattern", pattern)
+	}
+	if strings.Contains(pattern, "//") {
+		return fmt.Errorf("pattern %q must not contain empty path segments", pattern)
+	}
+	for _, segment := range strings.Split(pattern, "/") {
+		if strings.Contains(segment, "**") && segment != "**" {
+			return fmt.Errorf("pattern %q is invalid, '**' must be a whole path segment, e.g. 'org/**/*.jar'", pattern)
+		}
+	}
+
+	return nil
+}
+
+func validateWith(validate func(string) error) schema.SchemaValidateFunc {
+	return func(value interface{}, key string) ([]string, []error) {
+		v, ok := value.(string)
+		if !ok {
+			return nil, []error{fmt.Errorf("expected type of %s to be string", key)}
+		}
+		if err := validate(v); err != nil {
+			return nil, []error{fmt.Errorf("%s: %s", key, err)}
+		}
+		return nil, nil
+	}
+}
+
 func ResourceArtifactoryPermissionTargets() *schema.Resource {
 	target := ResourceArtifactoryPermissionTarget()
 	target.DeprecationMessage = "This resource has been deprecated in favour of artifactory_permission_target resource."
@@ -93,24 +156,34 @@ func ResourceArtifactoryPermissionTarget() *schema.Resource {
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"includes_pattern": {
-					Type:        schema.TypeSet,
-					Elem:        &schema.Schema{Type: schema.TypeString},
+					Type: schema.TypeSet,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validateWith(ValidateAntPattern),
+					},
 					Set:         schema.HashString,
 					Optional:    true,
 					Description: `The default value will be [""] if nothing is supplied`,
 				},
 				"excludes_pattern": {
-					Type:        schema.TypeSet,
-					Elem:        &schema.Schema{Type: schema.TypeString},
+					Type: schema.TypeSet,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validateWith(ValidateAntPattern),
+					},
 					Set:         schema.HashString,
 					Optional:    true,
 					Description: `The default value will be [] if nothing is supplied`,
 				},
 				"repositories": {
-					Type:     schema.TypeSet,
-					Elem:     &schema.Schema{Type: schema.TypeString},
-					Set:      schema.HashString,
-					Required: true,
+					Type: schema.TypeSet,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validateWith(ValidatePermissionTargetRepository),
+					},
+					Set:         schema.HashString,
+					Required:    true,
+					Description: "Repository keys, or `ANY`, `ANY LOCAL`, `ANY REMOTE` and `ANY DISTRIBUTION` to match all the repositories, or all the repositories of a class.",
 				},
 				"actions": {
 					Type:     schema.TypeList,
@@ -127,8 +200,17 @@ func ResourceArtifactoryPermissionTarget() *schema.Resource {
 			},
 		},
 	}
+	// the build section has its own repositories schema, without the `ANY` values
 	buildSchema := principalSchema
-	buildSchema.Elem.(*schema.Resource).Schema["repositories"].Description = `This can only be 1 value: "artifactory-build-info", and currently, validation of sets/lists is not allowed. Artifactory will reject the request if you change this`
+	buildSchema.Elem = &schema.Resource{Schema: util.MergeMaps(principalSchema.Elem.(*schema.Resource).Schema, map[string]*schema.Schema{
+		"repositories": {
+			Type:        schema.TypeSet,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Set:         schema.HashString,
+			Required:    true,
+			Description: `This can only be 1 value: "artifactory-build-info", and currently, validation of sets/lists is not allowed. Artifactory will reject the request if you change this`,
+		},
+	})}
 
 	return &schema.Resource{
 		CreateContext: resourcePermissionTargetCreate,
@@ -140,6 +222,13 @@ func ResourceArtifactoryPermissionTarget() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+			if diff.HasChange("repo") {
+				return diff.SetNewComputed("expand_any")
+			}
+			return nil
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -149,6 +238,13 @@ func ResourceArtifactoryPermissionTarget() *schema.Resource {
 			"repo":           &principalSchema,
 			"build":          &buildSchema,
 			"release_bundle": &principalSchema,
+			"expand_any": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Computed:    true,
+				Description: "Keys of the existing repositories currently covered by the `repo` section, with the `ANY` values expanded.",
+			},
 		},
 	}
 }
@@ -161,6 +257,54 @@ func hashPrincipal(o interface{}) int {
 	return part1 * part3
 }
 
+// verifyPermissionTargetRepositories checks the repositories explicitly listed in the `repo` section exist, as
+// Artifactory accepts unknown repository keys and grants nothing
+func verifyPermissionTargetRepositories(c *resty.Client, permissionTarget *PermissionTargetParams) error {
+	if permissionTarget.Repo == nil {
+		return nil
+	}
+
+	for _, repo := range permissionTarget.Repo.Repositories {
+		if slices.Contains(anyRepositories, repo) {
+			continue
+		}
+		if _, err := repository.CheckRepo(repo, c.R()); err != nil {
+			return fmt.Errorf("repository %s of permission target %s must exist in artifactory: %s", repo, permissionTarget.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// expandAnyRepositories returns the keys of the existing repositories covered by the section, explicitly or with one
+// of the `ANY` values. Virtual repositories are never covered by permission targets.
+func expandAnyRepositories(c *resty.Client, section *PermissionTargetSection) ([]string, error) {
+	if section == nil {
+		return []string{}, nil
+	}
+
+	var repos []struct {
+		Key  string `json:"key"`
+		Type string `json:"type"`
+	}
+	if _, err := c.R().SetResult(&repos).Get(strings.TrimSuffix(repository.RepositoriesEndpoint, "/")); err != nil {
+		return nil, err
+	}
+
+	keys := []string{}
+	for _, repo := range repos {
+		if strings.EqualFold(repo.Type, "virtual") {
+			continue
+		}
+		if permissionTargetAppliesTo(section.Repositories, repo.Key, repo.Type) {
+			keys = append(keys, repo.Key)
+		}
+	}
+	sort.Strings(keys)
+
+	return keys, nil
+}
+
 func unpackPermissionTarget(s *schema.ResourceData) *PermissionTargetParams {
 	d := &util.ResourceData{ResourceData: s}
 
@@ -251,7 +395,7 @@ func unpackPermissionTarget(s *schema.ResourceData) *PermissionTargetParams {
 	return pTarget
 }
 
-func packPermissionTarget(permissionTarget *PermissionTargetParams, d *schema.ResourceData) diag.Diagnostics {
+func packPermissionTarget(permissionTarget *PermissionTargetParams, c *resty.Client, d *schema.ResourceData) diag.Diagnostics {
 	packPermission := func(p *PermissionTargetSection) []interface{} {
 		packPermMap := func(e map[string][]string) []interface{} {
 			perm := make([]interface{}, len(e))
@@ -317,6 +461,12 @@ func packPermissionTarget(permissionTarget *PermissionTargetParams, d *schema.Re
 		errors = setValue("release_bundle", packPermission(permissionTarget.ReleaseBundle))
 	}
 
+	expandedRepositories, err := expandAnyRepositories(c, permissionTarget.Repo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	errors = setValue("expand_any", expandedRepositories)
+
 	if errors != nil && len(errors) > 0 {
 		return diag.Errorf("failed to marshal permission target %q", errors)
 	}
@@ -326,6 +476,10 @@ func packPermissionTarget(permissionTarget *PermissionTargetParams, d *schema.Re
 func resourcePermissionTargetCreate(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	permissionTarget := unpackPermissionTarget(d)
 
+	if err := verifyPermissionTargetRepositories(m.(*resty.Client), permissionTarget); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if _, err := m.(*resty.Client).R().AddRetryCondition(repository.Retry400).SetBody(permissionTarget).Post(permissionsEndPoint + permissionTarget.Name); err != nil {
 		return diag.FromErr(err)
 	}
@@ -345,12 +499,16 @@ func resourcePermissionTargetRead(_ context.Context, d *schema.ResourceData, m i
 		return diag.FromErr(err)
 	}
 
-	return packPermissionTarget(permissionTarget, d)
+	return packPermissionTarget(permissionTarget, m.(*resty.Client), d)
 }
 
 func resourcePermissionTargetUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	permissionTarget := unpackPermissionTarget(d)
 
+	if err := verifyPermissionTargetRepositories(m.(*resty.Client), permissionTarget); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if _, err := m.(*resty.Client).R().SetBody(permissionTarget).Put(permissionsEndPoint + d.Id()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,321 @@
+package security
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jfrog/terraform-provider-shared/client"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"golang.org/x/exp/slices"
+)
+
+// maximum number of read-modify-write attempts when the permission target is modified concurrently
+const permissionTargetGrantMaxAttempts = 5
+
+// Permission target section attribute names, and their JSON key in the permission target
+var permissionTargetSections = map[string]string{
+	"repo":           "repo",
+	"build":          "build",
+	"release_bundle": "releaseBundle",
+}
+
+// Grants of the same permission target are serialized within the provider, the API only supports replacing the whole target
+var permissionTargetLocks sync.Map
+
+func lockPermissionTarget(name string) func() {
+	lock, _ := permissionTargetLocks.LoadOrStore(name, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	return lock.(*sync.Mutex).Unlock
+}
+
+type PermissionTargetGrant struct {
+	Target        string
+	Section       string
+	PrincipalType string
+	Name          string
+	Permissions   []string
+}
+
+func (g PermissionTargetGrant) Id() string {
+	return strings.Join([]string{g.Target, g.Section, g.PrincipalType, g.Name}, ":")
+}
+
+// principalsKey key of the principals in the actions of a permission target section, i.e. `users` or `groups`
+func (g PermissionTargetGrant) principalsKey() string {
+	return g.PrincipalType + "s"
+}
+
+func parsePermissionTargetGrantId(id string) (PermissionTargetGrant, error) {
+	// the permission target name may contain ':', the other parts can't
+	parts := strings.Split(id, ":")
+	if len(parts) < 4 {
+		return PermissionTargetGrant{}, fmt.Errorf("invalid permission target grant ID %s, expected <target>:<section>:<principal_type>:<name>", id)
+	}
+
+	n := len(parts)
+	return PermissionTargetGrant{
+		Target:        strings.Join(parts[:n-3], ":"),
+		Section:       parts[n-3],
+		PrincipalType: parts[n-2],
+		Name:          parts[n-1],
+	}, nil
+}
+
+// readPermissionTarget reads the permission target as a generic map, so the rest of the permission target is sent back unchanged
+func readPermissionTarget(c *resty.Client, name string) (map[string]interface{}, *resty.Response, error) {
+	target := map[string]interface{}{}
+	resp, err := c.R().SetResult(&target).Get(permissionsEndPoint + name)
+	return target, resp, err
+}
+
+// grantedPermissions returns the permissions of the principal of the grant in the permission target, and false if
+// the principal has no permission
+func grantedPermissions(target map[string]interface{}, grant PermissionTargetGrant) ([]string, bool) {
+	section, ok := target[permissionTargetSections[grant.Section]].(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+	actions, ok := section["actions"].(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+	principals, ok := actions[grant.principalsKey()].(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+	permissions, ok := principals[grant.Name].([]interface{})
+	if !ok {
+		return nil, false
+	}
+
+	return util.CastToStringArr(permissions), true
+}
+
+// SetGrantedPermissions sets the permissions of the principal of the grant in the permission target, or removes the
+// principal when permissions is nil. The other principals are left untouched.
+func SetGrantedPermissions(target map[string]interface{}, grant PermissionTargetGrant, permissions []string) error {
+	section, ok := target[permissionTargetSections[grant.Section]].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("permission target %s has no %s section", grant.Target, grant.Section)
+	}
+
+	actions, ok := section["actions"].(map[string]interface{})
+	if !ok {
+		actions = map[string]interface{}{}
+		section["actions"] = actions
+	}
+
+	principals, ok := actions[grant.principalsKey()].(map[string]interface{})
+	if !ok {
+		principals = map[string]interface{}{}
+		actions[grant.principalsKey()] = principals
+	}
+
+	if permissions == nil {
+		delete(principals, grant.Name)
+		if len(principals) == 0 {
+			delete(actions, grant.principalsKey())
+		}
+	} else {
+		principals[grant.Name] = util.CastToInterfaceArr(permissions)
+	}
+
+	return nil
+}
+
+func samePermissions(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	sort.Strings(a)
+	sort.Strings(b)
+	return slices.Equal(a, b)
+}
+
+// updatePermissionTargetGrant read-modify-write of the permission target
+//
+// The permissions API has no conditional update (no ETag or If-Match), so a write made by another process between the
+// read and the write of the permission target is lost. The grant is read back after the write and retried when it was
+// overwritten, and a grant overwritten later is removed from the state by the next refresh, so the next apply grants it
+// again. Changes to the other principals are not detected.
+func updatePermissionTargetGrant(ctx context.Context, c *resty.Client, grant PermissionTargetGrant, permissions []string) error {
+	unlock := lockPermissionTarget(grant.Target)
+	defer unlock()
+
+	for attempt := 1; attempt <= permissionTargetGrantMaxAttempts; attempt++ {
+		target, _, err := readPermissionTarget(c, grant.Target)
+		if err != nil {
+			return err
+		}
+		if err := SetGrantedPermissions(target, grant, permissions); err != nil {
+			return err
+		}
+
+		_, err = c.R().
+			AddRetryCondition(client.RetryOnMergeError).
+			SetBody(target).
+			Put(permissionsEndPoint + grant.Target)
+		if err != nil {
+			return err
+		}
+
+		// verify the write has not been overwritten by a concurrent update of the permission target
+		written, _, err := readPermissionTarget(c, grant.Target)
+		if err != nil {
+			return err
+		}
+		actual, found := grantedPermissions(written, grant)
+		if (permissions == nil && !found) || (permissions != nil && found && samePermissions(actual, permissions)) {
+			return nil
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("permission target %s modified concurrently, retrying grant %s (attempt %d)", grant.Target, grant.Id(), attempt))
+	}
+
+	return fmt.Errorf("failed to update grant %s, permission target %s is modified concurrently", grant.Id(), grant.Target)
+}
+
+func ResourceArtifactoryPermissionTargetGrant() *schema.Resource {
+	var unpackPermissionTargetGrant = func(s *schema.ResourceData) PermissionTargetGrant {
+		d := &util.ResourceData{ResourceData: s}
+		return PermissionTargetGrant{
+			Target:        d.GetString("target", false),
+			Section:       d.GetString("section", false),
+			PrincipalType: d.GetString("principal_type", false),
+			Name:          d.GetString("name", false),
+			Permissions:   d.GetSet("permissions"),
+		}
+	}
+
+	var resourcePermissionTargetGrantRead = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		grant, err := parsePermissionTargetGrantId(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		target, resp, err := readPermissionTarget(m.(*resty.Client), grant.Target)
+		if err != nil {
+			if resp != nil && resp.StatusCode() == http.StatusNotFound {
+				tflog.Warn(ctx, fmt.Sprintf("permission target %s not found, removing grant %s from state", grant.Target, d.Id()))
+				d.SetId("")
+				return nil
+			}
+			return diag.FromErr(err)
+		}
+
+		permissions, found := grantedPermissions(target, grant)
+		if !found {
+			tflog.Warn(ctx, fmt.Sprintf("grant %s not found in permission target %s, removing from state", d.Id(), grant.Target))
+			d.SetId("")
+			return nil
+		}
+
+		setValue := util.MkLens(d)
+		setValue("target", grant.Target)
+		setValue("section", grant.Section)
+		setValue("principal_type", grant.PrincipalType)
+		setValue("name", grant.Name)
+		errors := setValue("permissions", permissions)
+		if errors != nil && len(errors) > 0 {
+			return diag.Errorf("failed to pack permission target grant %q", errors)
+		}
+
+		return nil
+	}
+
+	var resourcePermissionTargetGrantUpdate = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		grant := unpackPermissionTargetGrant(d)
+
+		if err := updatePermissionTargetGrant(ctx, m.(*resty.Client), grant, grant.Permissions); err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId(grant.Id())
+		return resourcePermissionTargetGrantRead(ctx, d, m)
+	}
+
+	var resourcePermissionTargetGrantDelete = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		grant := unpackPermissionTargetGrant(d)
+
+		err := updatePermissionTargetGrant(ctx, m.(*resty.Client), grant, nil)
+		if err != nil {
+			// the permission target has been deleted with all its grants
+			if exists, _ := PermTargetExists(grant.Target, m); !exists {
+				return nil
+			}
+		}
+
+		return diag.FromErr(err)
+	}
+
+	return &schema.Resource{
+		CreateContext: resourcePermissionTargetGrantUpdate,
+		ReadContext:   resourcePermissionTargetGrantRead,
+		UpdateContext: resourcePermissionTargetGrantUpdate,
+		DeleteContext: resourcePermissionTargetGrantDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"target": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "Name of the permission target.",
+			},
+			"section": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "repo",
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"repo", "build", "release_bundle"}, false),
+				Description:  "Section of the permission target the permissions apply to: `repo`, `build` or `release_bundle`. Default value is `repo`.",
+			},
+			"principal_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"user", "group"}, false),
+				Description:  "Type of the principal: `user` or `group`.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "Name of the user or group.",
+			},
+			"permissions": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						PermRead,
+						PermAnnotate,
+						PermWrite,
+						PermDelete,
+						PermManage,
+						PermManagedXrayMeta,
+						PermDistribute,
+					}, false),
+				},
+				Set:         schema.HashString,
+				Required:    true,
+				MinItems:    1,
+				Description: "Permissions granted to the principal.",
+			},
+		},
+		Description: "Grants permissions to a single user or group in an existing permission target. The other principals of the " +
+			"permission target are left untouched, so separate configurations can each manage their own grants.",
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"regexp"
 	"testing"
 
 	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/acctest"
@@ -166,7 +167,7 @@ func TestAccPermissionTarget_GitHubIssue126(t *testing.T) {
 		  repo {
 			includes_pattern = ["**"]
 			repositories = [
-			  "{{ .repo_name }}"
+			  artifactory_local_generic_repository.{{ .repo_name }}.key
 			]
 			actions {
 			  users {
@@ -194,12 +195,92 @@ func TestAccPermissionTarget_GitHubIssue126(t *testing.T) {
 					resource.TestCheckResourceAttr(permFqrn, "repo.0.actions.0.users.#", "1"),
 					resource.TestCheckResourceAttr(permFqrn, "repo.0.actions.0.users.0.permissions.#", "4"),
 					resource.TestCheckResourceAttr(permFqrn, "repo.0.repositories.#", "1"),
+					resource.TestCheckResourceAttr(permFqrn, "expand_any.#", "1"),
+					resource.TestCheckResourceAttr(permFqrn, "expand_any.0", repoName),
 				),
 			},
 		},
 	})
 }
 
+func TestAccPermissionTarget_AnyRepositories(t *testing.T) {
+	_, permFqrn, permName := test.MkNames("test-perm", "artifactory_permission_target")
+	_, _, repoName := test.MkNames("test-perm-repo", "artifactory_local_generic_repository")
+
+	mkConfig := func(repositories string) string {
+		return util.ExecuteTemplate(permFqrn, `
+			resource "artifactory_local_generic_repository" "{{ .repo_name }}" {
+			  key = "{{ .repo_name }}"
+			}
+
+			resource "artifactory_permission_target" "{{ .perm_name }}" {
+			  name = "{{ .perm_name }}"
+			  repo {
+				includes_pattern = ["**"]
+				repositories     = {{ .repositories }}
+				actions {
+				  users {
+					name        = "anonymous"
+					permissions = ["read"]
+				  }
+				}
+			  }
+			  depends_on = [artifactory_local_generic_repository.{{ .repo_name }}]
+			}`, map[string]string{
+			"perm_name":    permName,
+			"repo_name":    repoName,
+			"repositories": repositories,
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testPermissionTargetCheckDestroy(permFqrn),
+		Steps: []resource.TestStep{
+			{
+				Config: mkConfig(`["ANY LOCAL"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(permFqrn, "repo.0.repositories.#", "1"),
+					resource.TestCheckTypeSetElemAttr(permFqrn, "expand_any.*", repoName),
+				),
+			},
+			{
+				Config:      mkConfig(`["ANY LOCALS"]`),
+				ExpectError: regexp.MustCompile(`invalid repository "ANY LOCALS"`),
+			},
+			{
+				Config:      mkConfig(`["non-existing-repo"]`),
+				ExpectError: regexp.MustCompile(`repository non-existing-repo of permission target .* must exist in artifactory`),
+			},
+		},
+	})
+}
+
+func TestValidatePermissionTarget(t *testing.T) {
+	for _, repo := range []string{"ANY", "ANY LOCAL", "ANY REMOTE", "ANY DISTRIBUTION", "generic-local", "anything-local"} {
+		if err := security.ValidatePermissionTargetRepository(repo); err != nil {
+			t.Errorf("expected repository %q to be valid: %s", repo, err)
+		}
+	}
+	for _, repo := range []string{"any", "ANY LOCALS", "Any Remote", "ANY  LOCAL", "ANY VIRTUAL", "generic local", ""} {
+		if err := security.ValidatePermissionTargetRepository(repo); err == nil {
+			t.Errorf("expected repository %q to be invalid", repo)
+		}
+	}
+
+	for _, pattern := range []string{"", "**", "foo/**", "org/**/*.jar", "*.pom", "com/acme/?/file-*.txt"} {
+		if err := security.ValidateAntPattern(pattern); err != nil {
+			t.Errorf("expected pattern %q to be valid: %s", pattern, err)
+		}
+	}
+	for _, pattern := range []string{"foo/**bar", "***", "foo//bar", "foo\\**", "foo/**, bar/**", " foo/**"} {
+		if err := security.ValidateAntPattern(pattern); err == nil {
+			t.Errorf("expected pattern %q to be invalid", pattern)
+		}
+	}
+}
+
 func TestAccPermissionTarget_full(t *testing.T) {
 	_, permFqrn, permName := test.MkNames("test-perm", "artifactory_permission_target")
 
@@ -375,3 +456,112 @@ func testPermissionTargetCheckDestroy(id ...string) func(*terraform.State) error
 		return nil
 	}
 }
+
+func TestAccPermissionTargetGrant(t *testing.T) {
+	_, permFqrn, permName := test.MkNames("test-perm", "artifactory_permission_target")
+	_, grantFqrn, grantName := test.MkNames("test-grant", "artifactory_permission_target_grant")
+	_, _, repoName := test.MkNames("test-perm-repo", "artifactory_local_generic_repository")
+
+	mkConfig := func(permissions string) string {
+		return util.ExecuteTemplate(permFqrn, `
+			resource "artifactory_local_generic_repository" "{{ .repo_name }}" {
+			  key = "{{ .repo_name }}"
+			}
+
+			resource "artifactory_permission_target" "{{ .perm_name }}" {
+			  name = "{{ .perm_name }}"
+			  repo {
+				includes_pattern = ["**"]
+				repositories     = [artifactory_local_generic_repository.{{ .repo_name }}.key]
+				actions {
+				  users {
+					name        = "anonymous"
+					permissions = ["read"]
+				  }
+				}
+			  }
+
+			  lifecycle {
+				ignore_changes = [repo[0].actions[0].groups]
+			  }
+			}
+
+			resource "artifactory_permission_target_grant" "{{ .grant_name }}" {
+			  target         = artifactory_permission_target.{{ .perm_name }}.name
+			  principal_type = "group"
+			  name           = "readers"
+			  permissions    = {{ .permissions }}
+			}`, map[string]string{
+			"perm_name":   permName,
+			"grant_name":  grantName,
+			"repo_name":   repoName,
+			"permissions": permissions,
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testPermissionTargetCheckDestroy(permFqrn),
+		Steps: []resource.TestStep{
+			{
+				Config: mkConfig(`["read"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(grantFqrn, "id", fmt.Sprintf("%s:repo:group:readers", permName)),
+					resource.TestCheckResourceAttr(grantFqrn, "permissions.#", "1"),
+					resource.TestCheckResourceAttr(permFqrn, "repo.0.actions.0.users.#", "1"),
+				),
+			},
+			{
+				Config: mkConfig(`["read", "write"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(grantFqrn, "permissions.#", "2"),
+					resource.TestCheckResourceAttr(permFqrn, "repo.0.actions.0.users.#", "1"),
+				),
+			},
+			{
+				ResourceName:      grantFqrn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestSetGrantedPermissions(t *testing.T) {
+	target := map[string]interface{}{
+		"name": "my-target",
+		"repo": map[string]interface{}{
+			"repositories": []interface{}{"generic-local"},
+			"actions": map[string]interface{}{
+				"users": map[string]interface{}{
+					"anonymous": []interface{}{"read"},
+				},
+			},
+		},
+	}
+	grant := security.PermissionTargetGrant{Target: "my-target", Section: "repo", PrincipalType: "group", Name: "readers"}
+
+	if err := security.SetGrantedPermissions(target, grant, []string{"read", "write"}); err != nil {
+		t.Fatal(err)
+	}
+	actions := target["repo"].(map[string]interface{})["actions"].(map[string]interface{})
+	if len(actions["groups"].(map[string]interface{})["readers"].([]interface{})) != 2 {
+		t.Errorf("expected readers group to be granted 2 permissions, got %v", actions["groups"])
+	}
+	if _, ok := actions["users"].(map[string]interface{})["anonymous"]; !ok {
+		t.Error("expected anonymous user to be left untouched")
+	}
+
+	if err := security.SetGrantedPermissions(target, grant, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := actions["groups"]; ok {
+		t.Errorf("expected readers group to be removed, got %v", actions["groups"])
+	}
+
+	grant.Section = "build"
+	if err := security.SetGrantedPermissions(target, grant, []string{"read"}); err == nil {
+		t.Error("expected an error for a missing section")
+	}
+}
@@ -5,16 +5,21 @@ import (
 	"fmt"
 	"github.com/jfrog/terraform-provider-shared/packer"
 	"github.com/jfrog/terraform-provider-shared/predicate"
+	"net/http"
 	"regexp"
 	"strings"
 	"time"
 
 	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/artifactory/resource/repository"
 	"github.com/jfrog/terraform-provider-shared/util"
 	"github.com/jfrog/terraform-provider-shared/validator"
+	"golang.org/x/exp/slices"
 )
 
 type AccessTokenPostResponse struct {
@@ -42,6 +47,12 @@ func ResourceArtifactoryScopedToken() *schema.Resource {
 		Audience    string `json:"audience,omitempty"`
 	}
 
+	type AccessTokenRefreshRequest struct {
+		GrantType    string `json:"grant_type"`
+		RefreshToken string `json:"refresh_token"`
+		AccessToken  string `json:"access_token,omitempty"`
+	}
+
 	type AccessTokenGet struct {
 		TokenId     string `json:"token_id"`
 		Subject     string `json:"subject"`
@@ -139,6 +150,79 @@ func ResourceArtifactoryScopedToken() *schema.Resource {
 				"* `[\"applied-permissions/group\", \"artifact:generic-local/path:*\"]`\n" +
 				"* `[\"applied-permissions/admin\", \"system:metrics:r\", \"artifact:generic-local:*\"]`",
 		},
+		"group_scopes": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			ForceNew: true,
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+			},
+			Description: "Names of the groups to which permissions are assigned. Composed into the `applied-permissions/groups:<group-name>[,<group-name>...]` scope. " +
+				"The groups must exist in Artifactory.",
+		},
+		"project_scopes": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			ForceNew: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"project_key": {
+						Type:             schema.TypeString,
+						Required:         true,
+						ForceNew:         true,
+						ValidateDiagFunc: validator.ProjectKey,
+						Description:      "Key of the project.",
+					},
+					"roles": {
+						Type:     schema.TypeSet,
+						Required: true,
+						ForceNew: true,
+						MinItems: 1,
+						Elem: &schema.Schema{
+							Type:             schema.TypeString,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+						},
+						Description: "Names of the project roles, e.g. `Developer`.",
+					},
+				},
+			},
+			Description: "Project roles to which permissions are assigned. Each block is composed into an `applied-permissions/roles:<project-key>:<role>[,<role>...]` scope.",
+		},
+		"artifact_scopes": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			ForceNew: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"repository": {
+						Type:             schema.TypeString,
+						Required:         true,
+						ForceNew:         true,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+						Description:      "Key of the repository, or a pattern. A repository without wildcard must exist in Artifactory.",
+					},
+					"path": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						ForceNew:    true,
+						Description: "Path inside the repository, can be a pattern. When not set, the scope applies to the whole repository.",
+					},
+					"actions": {
+						Type:     schema.TypeSet,
+						Required: true,
+						ForceNew: true,
+						MinItems: 1,
+						Elem: &schema.Schema{
+							Type:             schema.TypeString,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"r", "w", "d", "a", "m", "*"}, false)),
+						},
+						Description: "Actions allowed on the repository: `r` (read), `w` (write), `d` (delete), `a` (annotate), `m` (manage), or `*` for all actions.",
+					},
+				},
+			},
+			Description: "Resource permissions on repositories. Each block is composed into an `artifact:<repository>[/<path>]:<actions>` scope.",
+		},
 		"expires_in": {
 			Type:             schema.TypeInt,
 			Optional:         true,
@@ -177,6 +261,31 @@ func ResourceArtifactoryScopedToken() *schema.Resource {
 				"Default to '*@*' if not set. Service ID must begin with valid JFrog service type. " +
 				"Options: jfrt, jfxr, jfpip, jfds, jfmc, jfac, jfevt, jfmd, jfcon, or *",
 		},
+		"rotate_before": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ValidateDiagFunc: validation.ToDiagFunc(func(i interface{}, k string) ([]string, []error) {
+				v, ok := i.(string)
+				if !ok {
+					return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+				}
+				if d, err := time.ParseDuration(v); err != nil || d <= 0 {
+					return nil, []error{fmt.Errorf("expected %q to be a positive duration (e.g. `72h`), got %q", k, v)}
+				}
+				return nil, nil
+			}),
+			Description: "Rotation window, as a duration (e.g. `72h`). When the remaining lifetime of the token is less than this " +
+				"duration, the token is replaced on the next apply. Only applies to tokens with an expiry. Must be shorter than the lifetime of the token.",
+		},
+		"rotation_trigger": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description: "Arbitrary map of values that, when changed, will trigger the replacement of the token.",
+		},
 		"access_token": {
 			Type:     schema.TypeString,
 			Computed: true,
@@ -207,10 +316,66 @@ func ResourceArtifactoryScopedToken() *schema.Resource {
 		},
 	}
 
+	// unpackStructuredScopes composes the scope tokens from `group_scopes`, `project_scopes` and `artifact_scopes`
+	var unpackStructuredScopes = func(data *schema.ResourceData) []string {
+		d := &util.ResourceData{ResourceData: data}
+		var scopes []string
+
+		if groups := d.GetSet("group_scopes"); len(groups) > 0 {
+			scopes = append(scopes, GroupsScope(groups))
+		}
+
+		for _, raw := range data.Get("project_scopes").(*schema.Set).List() {
+			projectScope := raw.(map[string]interface{})
+			scopes = append(scopes, ProjectScope(
+				projectScope["project_key"].(string),
+				util.CastToStringArr(projectScope["roles"].(*schema.Set).List()),
+			))
+		}
+
+		for _, raw := range data.Get("artifact_scopes").(*schema.Set).List() {
+			artifactScope := raw.(map[string]interface{})
+			scopes = append(scopes, ArtifactScope(
+				artifactScope["repository"].(string),
+				artifactScope["path"].(string),
+				util.CastToStringArr(artifactScope["actions"].(*schema.Set).List()),
+			))
+		}
+
+		return scopes
+	}
+
+	// verifyScopedResources checks the groups and repositories referenced by the structured scopes exist
+	var verifyScopedResources = func(data *schema.ResourceData, client *resty.Client) error {
+		d := &util.ResourceData{ResourceData: data}
+
+		for _, group := range d.GetSet("group_scopes") {
+			exist, err := checkGroupExists(client, group)
+			if err != nil {
+				return fmt.Errorf("group %s must exist in artifactory: %s", group, err)
+			}
+			if !exist {
+				return fmt.Errorf("group %s must exist in artifactory", group)
+			}
+		}
+
+		for _, raw := range data.Get("artifact_scopes").(*schema.Set).List() {
+			repo := raw.(map[string]interface{})["repository"].(string)
+			if strings.Contains(repo, "*") {
+				continue
+			}
+			if _, err := repository.CheckRepo(repo, client.R()); err != nil {
+				return fmt.Errorf("repository %s must exist in artifactory: %s", repo, err)
+			}
+		}
+
+		return nil
+	}
+
 	var unpackAccessTokenPostRequest = func(data *schema.ResourceData) (*AccessTokenPostRequest, error) {
 		d := &util.ResourceData{ResourceData: data}
 
-		scopes := d.GetSet("scopes")
+		scopes := append(d.GetSet("scopes"), unpackStructuredScopes(data)...)
 		scopesString := strings.Join(scopes, " ") // Join slice into space-separated string
 		if len(scopesString) > 500 {
 			return nil, fmt.Errorf("total combined length of scopes field exceeds 500 characters: %s", scopesString)
@@ -234,15 +399,16 @@ func ResourceArtifactoryScopedToken() *schema.Resource {
 		return &accessToken, nil
 	}
 
-	var accessTokenRead = func(_ context.Context, data *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var accessTokenRead = func(ctx context.Context, data *schema.ResourceData, m interface{}) diag.Diagnostics {
 		accessToken := AccessTokenGet{}
 
-		_, err := m.(*resty.Client).R().
-			SetPathParam("id", data.Id()).
-			SetResult(&accessToken).
-			Get("access/api/v1/tokens/{id}")
-
+		resp, err := CheckAccessToken(data.Id(), m.(*resty.Client).R().SetResult(&accessToken))
 		if err != nil {
+			if resp != nil && resp.StatusCode() == http.StatusNotFound {
+				tflog.Warn(ctx, fmt.Sprintf("scoped token %s has been revoked or has expired, removing from state", data.Id()))
+				data.SetId("")
+				return nil
+			}
 			return diag.FromErr(err)
 		}
 
@@ -254,7 +420,15 @@ func ResourceArtifactoryScopedToken() *schema.Resource {
 	var packAccessTokenPostResponse = func(d *schema.ResourceData, accessToken AccessTokenPostResponse) diag.Diagnostics {
 		setValue := util.MkLens(d)
 
-		setValue("scopes", strings.Split(accessToken.Scope, " "))
+		// scopes composed from the structured attributes are not part of `scopes`, to not conflict with the configuration
+		structuredScopes := unpackStructuredScopes(d)
+		var scopes []string
+		for _, scope := range strings.Split(accessToken.Scope, " ") {
+			if !slices.Contains(structuredScopes, scope) {
+				scopes = append(scopes, scope)
+			}
+		}
+		setValue("scopes", scopes)
 		setValue("expires_in", accessToken.ExpiresIn)
 		setValue("access_token", accessToken.AccessToken)
 
@@ -280,6 +454,10 @@ func ResourceArtifactoryScopedToken() *schema.Resource {
 
 		accessToken.GrantType = "client_credentials"
 
+		if err := verifyScopedResources(data, m.(*resty.Client)); err != nil {
+			return diag.FromErr(err)
+		}
+
 		result := AccessTokenPostResponse{}
 		_, err = m.(*resty.Client).R().
 			SetBody(accessToken).
@@ -323,12 +501,126 @@ func ResourceArtifactoryScopedToken() *schema.Resource {
 		return nil
 	}
 
+	// tokenData common methods of schema.ResourceData and schema.ResourceDiff used to check the rotation window
+	type tokenData interface {
+		Get(string) interface{}
+		GetOk(string) (interface{}, bool)
+	}
+
+	var isDueForRotation = func(data tokenData) bool {
+		rotateBefore, ok := data.GetOk("rotate_before")
+		if !ok {
+			return false
+		}
+
+		expiry := data.Get("expiry").(int)
+		if expiry == 0 { // non-expiring token
+			return false
+		}
+
+		return IsInRotationWindow(time.Unix(int64(expiry), 0), rotateBefore.(string), time.Now())
+	}
+
+	// isRenewable A refreshable token is renewed in place with its refresh token, instead of being replaced
+	var isRenewable = func(data tokenData) bool {
+		return data.Get("refreshable").(bool) && data.Get("refresh_token").(string) != ""
+	}
+
+	var accessTokenRefresh = func(ctx context.Context, data *schema.ResourceData, m interface{}) diag.Diagnostics {
+		d := &util.ResourceData{ResourceData: data}
+
+		refreshRequest := AccessTokenRefreshRequest{
+			GrantType:    "refresh_token",
+			RefreshToken: d.GetString("refresh_token", false),
+			AccessToken:  d.GetString("access_token", false),
+		}
+
+		result := AccessTokenPostResponse{}
+		_, err := m.(*resty.Client).R().
+			SetBody(refreshRequest).
+			SetResult(&result).
+			Post("access/api/v1/tokens")
+		if err != nil {
+			return diag.Errorf("failed to renew scoped token %s with its refresh token: %s", data.Id(), err)
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("scoped token %s renewed as %s", data.Id(), result.Id()))
+		// the renewed token may have a new ID, the previous token is revoked by Artifactory
+		if result.Id() != "" {
+			data.SetId(result.Id())
+		}
+
+		return packAccessTokenPostResponse(data, result)
+	}
+
+	var accessTokenUpdate = func(ctx context.Context, data *schema.ResourceData, m interface{}) diag.Diagnostics {
+		if isRenewable(data) && isDueForRotation(data) {
+			if diags := accessTokenRefresh(ctx, data, m); diags != nil {
+				return diags
+			}
+		}
+
+		return accessTokenRead(ctx, data, m)
+	}
+
+	// rotationDiff Renews (refreshable token) or replaces the token when its remaining lifetime falls inside the `rotate_before` window
+	var rotationDiff = func(ctx context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+		if diff.Id() == "" || !isDueForRotation(diff) {
+			return nil
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("scoped token %s expires at %s, within the rotation window of %s", diff.Id(), time.Unix(int64(diff.Get("expiry").(int)), 0), diff.Get("rotate_before")))
+
+		if isRenewable(diff) {
+			for _, key := range []string{"access_token", "refresh_token", "expiry", "issued_at"} {
+				if err := diff.SetNewComputed(key); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+
+		if err := diff.SetNewComputed("access_token"); err != nil {
+			return err
+		}
+		return diff.ForceNew("access_token")
+	}
+
+	// rotateBeforeDiff rejects a rotation window at least as long as the lifetime of the token, as the token would be
+	// renewed or replaced on every apply
+	var rotateBeforeDiff = func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+		rotateBefore, ok := diff.GetOk("rotate_before")
+		if !ok || !diff.NewValueKnown("rotate_before") {
+			return nil
+		}
+		window, err := time.ParseDuration(rotateBefore.(string))
+		if err != nil {
+			return nil
+		}
+
+		// the lifetime is unknown until the token is created when `expires_in` isn't set
+		var lifetime time.Duration
+		if expiresIn := diff.Get("expires_in").(int); diff.NewValueKnown("expires_in") && expiresIn > 0 {
+			lifetime = time.Duration(expiresIn) * time.Second
+		} else if expiry, issuedAt := diff.Get("expiry").(int), diff.Get("issued_at").(int); expiry > 0 && issuedAt > 0 {
+			lifetime = time.Duration(expiry-issuedAt) * time.Second
+		}
+
+		if lifetime > 0 && window >= lifetime {
+			return fmt.Errorf("rotate_before (%s) must be shorter than the lifetime of the token (%s), the token would be rotated on every apply", window, lifetime)
+		}
+		return nil
+	}
+
 	return &schema.Resource{
 		CreateContext: accessTokenCreate,
 		ReadContext:   accessTokenRead,
+		// only `rotate_before` can be updated, and refreshable tokens are renewed in place. Every other attribute forces a new token
+		UpdateContext: accessTokenUpdate,
 		DeleteContext: accessTokenDelete,
 
-		Schema: scopedTokenSchema,
+		Schema:        scopedTokenSchema,
+		CustomizeDiff: customdiff.All(rotateBeforeDiff, rotationDiff),
 		Description: "Create scoped tokens for any of the services in your JFrog Platform and to " +
 			"manage user access to these services. If left at the default setting, the token will " +
 			"be created with the user-identity scope, which allows users to identify themselves in " +
@@ -339,3 +631,49 @@ func ResourceArtifactoryScopedToken() *schema.Resource {
 func CheckAccessToken(id string, request *resty.Request) (*resty.Response, error) {
 	return request.SetPathParam("id", id).Get("access/api/v1/tokens/{id}")
 }
+
+// IsInRotationWindow returns true when the token expiring at expiry must be rotated, i.e. when its remaining lifetime
+// is less than the rotateBefore duration
+func IsInRotationWindow(expiry time.Time, rotateBefore string, now time.Time) bool {
+	window, err := time.ParseDuration(rotateBefore)
+	if err != nil {
+		return false
+	}
+
+	return expiry.Sub(now) < window
+}
+
+// GroupsScope returns the scope token assigning the permissions of the groups
+func GroupsScope(groups []string) string {
+	sorted := slices.Clone(groups)
+	slices.Sort(sorted)
+	return "applied-permissions/groups:" + strings.Join(sorted, ",")
+}
+
+// ProjectScope returns the scope token assigning the permissions of the roles in the project
+func ProjectScope(projectKey string, roles []string) string {
+	sorted := slices.Clone(roles)
+	slices.Sort(sorted)
+	return fmt.Sprintf("applied-permissions/roles:%s:%s", projectKey, strings.Join(sorted, ","))
+}
+
+// ArtifactScope returns the `artifact:<repository>[/<path>]:<actions>` resource permission scope token
+func ArtifactScope(repo, path string, actions []string) string {
+	target := repo
+	if path != "" {
+		target = fmt.Sprintf("%s/%s", repo, strings.TrimPrefix(path, "/"))
+	}
+
+	if slices.Contains(actions, "*") {
+		return fmt.Sprintf("artifact:%s:*", target)
+	}
+
+	// keep the order of the API documentation
+	var ordered []string
+	for _, action := range []string{"r", "w", "d", "a", "m"} {
+		if slices.Contains(actions, action) {
+			ordered = append(ordered, action)
+		}
+	}
+	return fmt.Sprintf("artifact:%s:%s", target, strings.Join(ordered, ","))
+}
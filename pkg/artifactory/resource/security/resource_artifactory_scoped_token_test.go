@@ -3,9 +3,11 @@ package security_test
 import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/acctest"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/artifactory/resource/security"
 	"github.com/jfrog/terraform-provider-shared/test"
@@ -63,6 +65,150 @@ func TestAccScopedToken_WithDefaults(t *testing.T) {
 	})
 }
 
+func TestAccScopedToken_RotateBefore(t *testing.T) {
+	_, fqrn, name := test.MkNames("test-access-token", "artifactory_scoped_token")
+
+	mkConfig := func(rotateBefore string) string {
+		return util.ExecuteTemplate(
+			"TestAccScopedToken",
+			`resource "artifactory_user" "test-user" {
+				name              = "testuser"
+				email             = "testuser@tempurl.org"
+				admin             = true
+				disable_ui_access = false
+				groups            = ["readers"]
+				password          = "Passw0rd!"
+			}
+
+			resource "artifactory_scoped_token" "{{ .name }}" {
+				username      = artifactory_user.test-user.name
+				expires_in    = 120
+				rotate_before = "{{ .rotate_before }}"
+
+				lifecycle {
+					create_before_destroy = true
+				}
+			}`,
+			map[string]interface{}{
+				"name":          name,
+				"rotate_before": rotateBefore,
+			},
+		)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      acctest.VerifyDeleted(fqrn, security.CheckAccessToken),
+		Steps: []resource.TestStep{
+			{
+				Config: mkConfig("1m"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "rotate_before", "1m"),
+					resource.TestCheckResourceAttrSet(fqrn, "expiry"),
+				),
+			},
+			{
+				// the token expires in less than 110 seconds, which is inside the window
+				PreConfig:          func() { time.Sleep(15 * time.Second) },
+				Config:             mkConfig("110s"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// the token would be replaced on every apply
+				Config:      mkConfig("2m"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(".*rotate_before \\(2m0s\\) must be shorter than the lifetime of the token \\(2m0s\\).*"),
+			},
+		},
+	})
+}
+
+func TestAccScopedToken_RenewRefreshableToken(t *testing.T) {
+	_, fqrn, name := test.MkNames("test-access-token", "artifactory_scoped_token")
+
+	mkConfig := func(rotateBefore string) string {
+		return util.ExecuteTemplate(
+			"TestAccScopedToken",
+			`resource "artifactory_user" "test-user" {
+				name              = "testuser"
+				email             = "testuser@tempurl.org"
+				admin             = true
+				disable_ui_access = false
+				groups            = ["readers"]
+				password          = "Passw0rd!"
+			}
+
+			resource "artifactory_scoped_token" "{{ .name }}" {
+				username      = artifactory_user.test-user.name
+				expires_in    = 120
+				refreshable   = true
+				rotate_before = "{{ .rotate_before }}"
+			}`,
+			map[string]interface{}{
+				"name":          name,
+				"rotate_before": rotateBefore,
+			},
+		)
+	}
+
+	var accessToken string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      acctest.VerifyDeleted(fqrn, security.CheckAccessToken),
+		Steps: []resource.TestStep{
+			{
+				Config: mkConfig("1m"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(fqrn, "refresh_token"),
+					func(s *terraform.State) error {
+						accessToken = s.RootModule().Resources[fqrn].Primary.Attributes["access_token"]
+						return nil
+					},
+				),
+			},
+			{
+				// the token expires in less than 110 seconds, which is inside the window, so it's renewed once
+				PreConfig: func() { time.Sleep(15 * time.Second) },
+				Config:    mkConfig("110s"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(fqrn, "refresh_token"),
+					func(s *terraform.State) error {
+						if s.RootModule().Resources[fqrn].Primary.Attributes["access_token"] == accessToken {
+							return fmt.Errorf("expected access token to be renewed")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestIsInRotationWindow(t *testing.T) {
+	now := time.Now()
+
+	testCases := []struct {
+		expiry       time.Time
+		rotateBefore string
+		expected     bool
+	}{
+		{expiry: now.Add(72 * time.Hour), rotateBefore: "24h", expected: false},
+		{expiry: now.Add(12 * time.Hour), rotateBefore: "24h", expected: true},
+		{expiry: now.Add(-time.Hour), rotateBefore: "24h", expected: true},
+		{expiry: now.Add(12 * time.Hour), rotateBefore: "invalid", expected: false},
+	}
+
+	for _, tc := range testCases {
+		if actual := security.IsInRotationWindow(tc.expiry, tc.rotateBefore, now); actual != tc.expected {
+			t.Errorf("expiry %s with rotate_before %s: expected %t, got %t", tc.expiry, tc.rotateBefore, tc.expected, actual)
+		}
+	}
+}
+
 func TestAccScopedToken_WithAttributes(t *testing.T) {
 	_, fqrn, name := test.MkNames("test-access-token", "artifactory_scoped_token")
 
@@ -153,6 +299,99 @@ func TestAccScopedToken_WithGroupScope(t *testing.T) {
 	})
 }
 
+func TestAccScopedToken_WithStructuredScopes(t *testing.T) {
+	_, fqrn, name := test.MkNames("test-access-token", "artifactory_scoped_token")
+	_, _, repoName := test.MkNames("generic-local", "artifactory_local_generic_repository")
+
+	accessTokenConfig := util.ExecuteTemplate(
+		"TestAccScopedToken",
+		`resource "artifactory_group" "test-group" {
+			name = "{{ .groupName }}"
+		}
+
+		resource "artifactory_local_generic_repository" "{{ .repoName }}" {
+			key = "{{ .repoName }}"
+		}
+
+		resource "artifactory_scoped_token" "{{ .name }}" {
+			username     = artifactory_group.test-group.name
+			group_scopes = [artifactory_group.test-group.name]
+
+			artifact_scopes {
+				repository = artifactory_local_generic_repository.{{ .repoName }}.key
+				actions    = ["r", "w"]
+			}
+		}`,
+		map[string]interface{}{
+			"name":      name,
+			"groupName": "test-group",
+			"repoName":  repoName,
+		},
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      acctest.VerifyDeleted(fqrn, security.CheckAccessToken),
+		Steps: []resource.TestStep{
+			{
+				Config: accessTokenConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "group_scopes.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "artifact_scopes.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "scopes.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccScopedToken_WithMissingScopedRepository(t *testing.T) {
+	_, _, name := test.MkNames("test-access-token", "artifactory_scoped_token")
+
+	accessTokenConfig := util.ExecuteTemplate(
+		"TestAccScopedToken",
+		`resource "artifactory_scoped_token" "{{ .name }}" {
+			artifact_scopes {
+				repository = "non-existing-repo"
+				actions    = ["r"]
+			}
+		}`,
+		map[string]interface{}{
+			"name": name,
+		},
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      accessTokenConfig,
+				ExpectError: regexp.MustCompile("repository non-existing-repo must exist in artifactory"),
+			},
+		},
+	})
+}
+
+func TestStructuredScopes(t *testing.T) {
+	testCases := []struct {
+		actual   string
+		expected string
+	}{
+		{actual: security.GroupsScope([]string{"readers", "deployers"}), expected: "applied-permissions/groups:deployers,readers"},
+		{actual: security.ProjectScope("myproj", []string{"Developer"}), expected: "applied-permissions/roles:myproj:Developer"},
+		{actual: security.ArtifactScope("generic-local", "", []string{"w", "r"}), expected: "artifact:generic-local:r,w"},
+		{actual: security.ArtifactScope("generic-local", "/path/**", []string{"r", "*"}), expected: "artifact:generic-local/path/**:*"},
+	}
+
+	for _, tc := range testCases {
+		if tc.actual != tc.expected {
+			t.Errorf("expected scope %s, got %s", tc.expected, tc.actual)
+		}
+	}
+}
+
 func TestAccScopedToken_WithInvalidScopes(t *testing.T) {
 	_, _, name := test.MkNames("test-scoped-token", "artifactory_scoped_token")
 
@@ -0,0 +1,194 @@
+package security
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/artifactory/resource/repository"
+	"github.com/jfrog/terraform-provider-shared/client"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+// Key pair type required to sign the metadata of each package type, alpine repositories only have a primary key pair
+var signingKeyTypes = map[string]string{
+	"debian": "GPG",
+	"rpm":    "GPG",
+	"alpine": "RSA",
+}
+
+// SigningKeyType returns the key pair type required to sign the metadata of the package type, and false if the
+// package type doesn't support signing
+func SigningKeyType(packageType string) (string, bool) {
+	keyType, ok := signingKeyTypes[packageType]
+	return keyType, ok
+}
+
+func ResourceArtifactorySigningKeyBinding() *schema.Resource {
+	// the repository is read and written as a generic map, so the rest of its configuration is sent back unchanged
+	var readRepository = func(c *resty.Client, key string) (map[string]interface{}, *resty.Response, error) {
+		repo := map[string]interface{}{}
+		resp, err := c.R().SetResult(&repo).Get(repository.RepositoriesEndpoint + key)
+		return repo, resp, err
+	}
+
+	var verifySigningKeyPair = func(c *resty.Client, pairName, keyType string) error {
+		keyPair := KeyPairPayLoad{}
+		resp, err := c.R().SetResult(&keyPair).Get(KeypairEndPoint + pairName)
+		if err != nil {
+			if resp != nil && resp.StatusCode() == http.StatusNotFound {
+				return fmt.Errorf("keypair %s not found", pairName)
+			}
+			return err
+		}
+		if keyPair.PairType != keyType {
+			return fmt.Errorf("keypair %s is of type %s, a %s keypair is required", pairName, keyPair.PairType, keyType)
+		}
+		return nil
+	}
+
+	var writeSigningKeyBinding = func(c *resty.Client, repoKey, primary, secondary string) diag.Diagnostics {
+		repo, _, err := readRepository(c, repoKey)
+		if err != nil {
+			return diag.Errorf("failed to read repository %s: %s", repoKey, err)
+		}
+
+		packageType, _ := repo["packageType"].(string)
+		keyType, ok := SigningKeyType(packageType)
+		if !ok {
+			return diag.Errorf("repository %s is a %s repository, only debian, rpm and alpine repositories are signed", repoKey, packageType)
+		}
+		if packageType == "alpine" && secondary != "" {
+			return diag.Errorf("alpine repository %s has no secondary keypair", repoKey)
+		}
+
+		for _, pairName := range []string{primary, secondary} {
+			if pairName == "" {
+				continue
+			}
+			if err := verifySigningKeyPair(c, pairName, keyType); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		repo["primaryKeyPairRef"] = primary
+		if packageType != "alpine" {
+			repo["secondaryKeyPairRef"] = secondary
+		}
+
+		_, err = c.R().
+			AddRetryCondition(client.RetryOnMergeError).
+			SetBody(repo).
+			Post(repository.RepositoriesEndpoint + repoKey)
+		if err != nil {
+			return diag.Errorf("failed to update repository %s: %s", repoKey, err)
+		}
+
+		return nil
+	}
+
+	var resourceSigningKeyBindingRead = func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		repo, resp, err := readRepository(m.(*resty.Client), d.Id())
+		if err != nil {
+			if resp != nil && (resp.StatusCode() == http.StatusBadRequest || resp.StatusCode() == http.StatusNotFound) {
+				d.SetId("")
+				return nil
+			}
+			return diag.FromErr(err)
+		}
+
+		primary, _ := repo["primaryKeyPairRef"].(string)
+		secondary, _ := repo["secondaryKeyPairRef"].(string)
+		if primary == "" {
+			// the binding was removed outside of terraform
+			d.SetId("")
+			return nil
+		}
+
+		setValue := util.MkLens(d)
+		setValue("repo_key", d.Id())
+		setValue("primary_keypair_ref", primary)
+		errors := setValue("secondary_keypair_ref", secondary)
+		if errors != nil && len(errors) > 0 {
+			return diag.Errorf("failed to pack signing key binding %q", errors)
+		}
+
+		return nil
+	}
+
+	var resourceSigningKeyBindingUpdate = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		repoKey := d.Get("repo_key").(string)
+		if diags := writeSigningKeyBinding(m.(*resty.Client), repoKey, d.Get("primary_keypair_ref").(string), d.Get("secondary_keypair_ref").(string)); diags != nil {
+			return diags
+		}
+
+		d.SetId(repoKey)
+		return resourceSigningKeyBindingRead(ctx, d, m)
+	}
+
+	var resourceSigningKeyBindingDelete = func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		c := m.(*resty.Client)
+
+		repo, resp, err := readRepository(c, d.Id())
+		if err != nil {
+			if resp != nil && (resp.StatusCode() == http.StatusBadRequest || resp.StatusCode() == http.StatusNotFound) {
+				return nil
+			}
+			return diag.FromErr(err)
+		}
+
+		repo["primaryKeyPairRef"] = ""
+		if _, ok := repo["secondaryKeyPairRef"]; ok {
+			repo["secondaryKeyPairRef"] = ""
+		}
+
+		_, err = c.R().
+			AddRetryCondition(client.RetryOnMergeError).
+			SetBody(repo).
+			Post(repository.RepositoriesEndpoint + d.Id())
+		if err != nil {
+			return diag.Errorf("failed to update repository %s: %s", d.Id(), err)
+		}
+
+		return nil
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceSigningKeyBindingUpdate,
+		ReadContext:   resourceSigningKeyBindingRead,
+		UpdateContext: resourceSigningKeyBindingUpdate,
+		DeleteContext: resourceSigningKeyBindingDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repo_key": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+				Description:      "Key of the debian, rpm or alpine repository, local or virtual.",
+			},
+			"primary_keypair_ref": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+				Description:      "Name of the keypair used to sign the metadata of the repository: a GPG keypair for debian and rpm repositories, an RSA keypair for alpine repositories.",
+			},
+			"secondary_keypair_ref": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+				Description:      "Name of the secondary GPG keypair of a debian or rpm repository, used when rotating the primary keypair.",
+			},
+		},
+
+		Description: "Assigns signing keypairs to a debian, rpm or alpine repository. The keypairs must exist and be of the type required by the package type.",
+	}
+}
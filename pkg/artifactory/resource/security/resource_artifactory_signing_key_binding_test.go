@@ -0,0 +1,131 @@
+package security_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/acctest"
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/artifactory/resource/security"
+	"github.com/jfrog/terraform-provider-shared/test"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccSigningKeyBinding(t *testing.T) {
+	_, fqrn, name := test.MkNames("signing-binding", "artifactory_signing_key_binding")
+	repoKey := fmt.Sprintf("debian-local-%s", name)
+
+	mkConfig := func(algorithm string) string {
+		return util.ExecuteTemplate("TestAccSigningKeyBinding", `
+			resource "artifactory_keypair" "{{ .name }}" {
+				pair_name = "{{ .name }}-{{ .algorithm }}"
+				alias     = "{{ .name }}-{{ .algorithm }}"
+
+				generate {
+					algorithm    = "{{ .algorithm }}"
+					gpg_identity = "Release Signing <release@example.com>"
+				}
+			}
+
+			resource "artifactory_local_debian_repository" "{{ .name }}" {
+				key = "{{ .repo_key }}"
+
+				lifecycle {
+					ignore_changes = [primary_keypair_ref, secondary_keypair_ref]
+				}
+			}
+
+			resource "artifactory_signing_key_binding" "{{ .name }}" {
+				repo_key            = artifactory_local_debian_repository.{{ .name }}.key
+				primary_keypair_ref = artifactory_keypair.{{ .name }}.pair_name
+			}
+		`, map[string]string{
+			"name":      name,
+			"repo_key":  repoKey,
+			"algorithm": algorithm,
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      mkConfig("RSA"),
+				ExpectError: regexp.MustCompile("a GPG keypair is required"),
+			},
+			{
+				Config: mkConfig("GPG"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "repo_key", repoKey),
+					resource.TestCheckResourceAttr(fqrn, "primary_keypair_ref", fmt.Sprintf("%s-GPG", name)),
+				),
+			},
+			{
+				ResourceName:      fqrn,
+				ImportState:       true,
+				ImportStateId:     repoKey,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDefaultSigningKey(t *testing.T) {
+	fqrn := "artifactory_default_signing_key.default"
+
+	privateKey, publicKey, err := security.GenerateKeyPair("GPG", 2048, "Default Signing <signing@example.com>", "Passw0rd!")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := fmt.Sprintf(`
+		resource "artifactory_default_signing_key" "default" {
+			public_key  = <<EOF
+%s
+EOF
+			private_key = <<EOF
+%s
+EOF
+			passphrase  = "Passw0rd!"
+		}
+	`, publicKey, privateKey)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			resp, err := acctest.GetTestResty(t).R().Get("artifactory/api/gpg/key/public")
+			if err == nil {
+				return fmt.Errorf("error: default signing key still exists: %s", resp.String())
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttrSet(fqrn, "public_key"),
+			},
+			{
+				ResourceName:            fqrn,
+				ImportState:             true,
+				ImportStateId:           "default_signing_key",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"public_key", "private_key", "passphrase"},
+			},
+		},
+	})
+}
+
+func TestSigningKeyType(t *testing.T) {
+	for packageType, expected := range map[string]string{"debian": "GPG", "rpm": "GPG", "alpine": "RSA"} {
+		if keyType, ok := security.SigningKeyType(packageType); !ok || keyType != expected {
+			t.Errorf("expected %s key for %s, got %s", expected, packageType, keyType)
+		}
+	}
+	if _, ok := security.SigningKeyType("maven"); ok {
+		t.Error("maven repositories aren't signed")
+	}
+}
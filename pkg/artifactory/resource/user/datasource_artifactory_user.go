@@ -0,0 +1,231 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"golang.org/x/exp/slices"
+)
+
+// userDataSourceSchema attributes of a user read by the data sources
+func userDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Username of the user.",
+		},
+		"email": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Email of the user.",
+		},
+		"admin": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the user is an administrator.",
+		},
+		"profile_updatable": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the user can update their profile details.",
+		},
+		"disable_ui_access": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the user can only access the system through the REST API.",
+		},
+		"internal_password_disabled": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the fallback to the internal password is disabled when external authentication is enabled.",
+		},
+		"groups": {
+			Type:        schema.TypeSet,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Set:         schema.HashString,
+			Computed:    true,
+			Description: "Groups the user is a member of.",
+		},
+		"ssh_public_key": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "SSH public key of the user.",
+		},
+		"last_logged_in": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Time of the last login of the user, empty if the user never logged in.",
+		},
+		"realm": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Realm of the user, e.g. `internal`, `ldap` or `saml`.",
+		},
+	}
+}
+
+func readUser(c *resty.Client, name string) (*User, error) {
+	user := &User{}
+	resp, err := c.R().SetResult(user).Get(usersEndpointPath + name)
+	if err != nil {
+		if resp != nil && resp.StatusCode() == http.StatusNotFound {
+			return nil, fmt.Errorf("user %s not found", name)
+		}
+		return nil, err
+	}
+	return user, nil
+}
+
+func DataSourceArtifactoryUser() *schema.Resource {
+	userSchema := userDataSourceSchema()
+	userSchema["name"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Required:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+		Description:      "Username of the user.",
+	}
+
+	var dataSourceUserRead = func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		user, err := readUser(m.(*resty.Client), d.Get("name").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId(user.Name)
+
+		if diags := packUser(*user, d); diags != nil {
+			return diags
+		}
+
+		setValue := util.MkLens(d)
+		setValue("last_logged_in", user.LastLoggedIn)
+		errors := setValue("realm", user.Realm)
+		if errors != nil && len(errors) > 0 {
+			return diag.Errorf("failed to pack user %q", errors)
+		}
+
+		return nil
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceUserRead,
+		Schema:      userSchema,
+		Description: "Provides the details of a user, including users provisioned by an external realm.",
+	}
+}
+
+func DataSourceArtifactoryUsers() *schema.Resource {
+	var packUsers = func(users []*User) []interface{} {
+		packed := make([]interface{}, 0, len(users))
+		for _, user := range users {
+			groups := user.Groups
+			if groups == nil {
+				groups = []string{}
+			}
+			packed = append(packed, map[string]interface{}{
+				"name":                       user.Name,
+				"email":                      user.Email,
+				"admin":                      user.Admin,
+				"profile_updatable":          user.ProfileUpdatable,
+				"disable_ui_access":          user.DisableUIAccess,
+				"internal_password_disabled": user.InternalPasswordDisabled,
+				"groups":                     schema.NewSet(schema.HashString, util.CastToInterfaceArr(groups)),
+				"ssh_public_key":             user.GetSshPublicKey(),
+				"last_logged_in":             user.LastLoggedIn,
+				"realm":                      user.Realm,
+			})
+		}
+		return packed
+	}
+
+	var dataSourceUsersRead = func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		c := m.(*resty.Client)
+		realm := d.Get("realm").(string)
+		group := d.Get("group").(string)
+
+		var list []struct {
+			Name  string `json:"name"`
+			Realm string `json:"realm"`
+		}
+		if _, err := c.R().SetResult(&list).Get(strings.TrimSuffix(usersEndpointPath, "/")); err != nil {
+			return diag.FromErr(err)
+		}
+
+		var members []string
+		if group != "" {
+			groupMembers, found, err := groupMembers(c, group)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if !found {
+				return diag.Errorf("group %s not found", group)
+			}
+			members = groupMembers
+		}
+
+		var names []string
+		for _, user := range list {
+			if realm != "" && !strings.EqualFold(user.Realm, realm) {
+				continue
+			}
+			if group != "" && !slices.Contains(members, user.Name) {
+				continue
+			}
+			names = append(names, user.Name)
+		}
+		sort.Strings(names)
+
+		users := make([]*User, 0, len(names))
+		for _, name := range names {
+			user, err := readUser(c, name)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			users = append(users, user)
+		}
+
+		d.SetId(fmt.Sprintf("users/realm=%s/group=%s", realm, group))
+
+		setValue := util.MkLens(d)
+		errors := setValue("users", packUsers(users))
+		if errors != nil && len(errors) > 0 {
+			return diag.Errorf("failed to pack users %q", errors)
+		}
+
+		return nil
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceUsersRead,
+
+		Schema: map[string]*schema.Schema{
+			"realm": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the users of this realm, e.g. `internal`, `ldap` or `saml`.",
+			},
+			"group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the members of this group.",
+			},
+			"users": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Resource{Schema: userDataSourceSchema()},
+				Description: "Users matching the filters, sorted by name.",
+			},
+		},
+
+		Description: "Provides the list of users, optionally filtered by realm or group.",
+	}
+}
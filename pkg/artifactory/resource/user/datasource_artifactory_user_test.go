@@ -0,0 +1,66 @@
+package user_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/acctest"
+	"github.com/jfrog/terraform-provider-shared/test"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccDataSourceUserAndUsers(t *testing.T) {
+	_, fqrn, name := test.MkNames("test-user", "artifactory_user")
+	_, _, groupName := test.MkNames("test-group", "artifactory_group")
+
+	config := util.ExecuteTemplate(fqrn, `
+		resource "artifactory_group" "{{ .group_name }}" {
+		  name = "{{ .group_name }}"
+		}
+
+		resource "artifactory_user" "{{ .name }}" {
+		  name     = "{{ .name }}"
+		  email    = "{{ .name }}@example.com"
+		  password = "Passw0rd!"
+		  groups   = [artifactory_group.{{ .group_name }}.name]
+		}
+
+		data "artifactory_user" "{{ .name }}" {
+		  name = artifactory_user.{{ .name }}.name
+		}
+
+		data "artifactory_users" "{{ .name }}" {
+		  realm      = "internal"
+		  group      = artifactory_group.{{ .group_name }}.name
+		  depends_on = [artifactory_user.{{ .name }}]
+		}
+
+		data "artifactory_group" "{{ .name }}" {
+		  name       = artifactory_group.{{ .group_name }}.name
+		  depends_on = [artifactory_user.{{ .name }}]
+		}
+	`, map[string]string{
+		"name":       name,
+		"group_name": groupName,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckUserDestroy(fqrn),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.artifactory_user."+name, "email", name+"@example.com"),
+					resource.TestCheckResourceAttr("data.artifactory_user."+name, "realm", "internal"),
+					resource.TestCheckResourceAttr("data.artifactory_user."+name, "groups.#", "1"),
+					resource.TestCheckResourceAttr("data.artifactory_users."+name, "users.#", "1"),
+					resource.TestCheckResourceAttr("data.artifactory_users."+name, "users.0.name", name),
+					resource.TestCheckResourceAttr("data.artifactory_group."+name, "users_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr("data.artifactory_group."+name, "users_names.*", name),
+				),
+			},
+		},
+	})
+}
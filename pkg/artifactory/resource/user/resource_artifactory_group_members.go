@@ -0,0 +1,155 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"golang.org/x/exp/slices"
+)
+
+func ResourceArtifactoryGroupMembers() *schema.Resource {
+	var resourceGroupMembersRead = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		groupName := d.Id()
+
+		members, found, err := groupMembers(m.(*resty.Client), groupName)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if !found {
+			tflog.Warn(ctx, fmt.Sprintf("group %s not found, removing its members from state", groupName))
+			d.SetId("")
+			return nil
+		}
+
+		// the conflicts with another resource managing the same memberships are only detected here, as warnings
+		var diags diag.Diagnostics
+		stateMembers := util.CastToStringArr(d.Get("members").(*schema.Set).List())
+		for _, member := range stateMembers {
+			if !slices.Contains(members, member) {
+				diags = append(diags, membershipRemovedWarning(member, groupName))
+			}
+		}
+		// nothing to compare with when the resource is imported
+		if len(stateMembers) > 0 {
+			if d.Get("exclusive").(bool) {
+				for _, member := range members {
+					if !slices.Contains(stateMembers, member) {
+						diags = append(diags, membershipAddedWarning(member, groupName))
+					}
+				}
+			} else {
+				// the other members of the group are not managed by this resource
+				managedMembers := []string{}
+				for _, member := range members {
+					if slices.Contains(stateMembers, member) {
+						managedMembers = append(managedMembers, member)
+					}
+				}
+				members = managedMembers
+			}
+		}
+
+		setValue := util.MkLens(d)
+		setValue("group", groupName)
+		errors := setValue("members", members)
+		if errors != nil && len(errors) > 0 {
+			return append(diags, diag.Errorf("failed to pack group members %q", errors)...)
+		}
+
+		return diags
+	}
+
+	// resourceGroupMembersUpdate only adds the new members to the group and removes the old ones, the memberships of
+	// the unchanged members are not touched
+	var resourceGroupMembersUpdate = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		groupName := d.Get("group").(string)
+		c := m.(*resty.Client)
+
+		members, found, err := groupMembers(c, groupName)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if !found {
+			return diag.Errorf("group %s not found", groupName)
+		}
+
+		currentMembers := schema.NewSet(schema.HashString, util.CastToInterfaceArr(members))
+		oldMembers, newMembers := d.GetChange("members")
+		added := newMembers.(*schema.Set).Difference(currentMembers)
+		// only the members removed from the configuration are removed, unless the resource is exclusive: the members
+		// of the group before it's managed by this resource, and the ones added outside of terraform, are removed as well
+		removed := oldMembers.(*schema.Set).Difference(newMembers.(*schema.Set)).Intersection(currentMembers)
+		if d.Get("exclusive").(bool) {
+			removed = currentMembers.Difference(newMembers.(*schema.Set))
+		}
+
+		for _, member := range util.CastToStringArr(added.List()) {
+			if err := updateUserGroups(c, member, []string{groupName}, nil); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		for _, member := range util.CastToStringArr(removed.List()) {
+			if err := updateUserGroups(c, member, nil, []string{groupName}); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		d.SetId(groupName)
+		return resourceGroupMembersRead(ctx, d, m)
+	}
+
+	var resourceGroupMembersDelete = func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		c := m.(*resty.Client)
+
+		for _, member := range util.CastToStringArr(d.Get("members").(*schema.Set).List()) {
+			if err := updateUserGroups(c, member, nil, []string{d.Id()}); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		return nil
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceGroupMembersUpdate,
+		ReadContext:   resourceGroupMembersRead,
+		UpdateContext: resourceGroupMembersUpdate,
+		DeleteContext: resourceGroupMembersDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+				Description:      "Name of the group.",
+			},
+			"members": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Required:    true,
+				Description: "Names of the users of the group.",
+			},
+			"exclusive": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "When set, the other members of the group, including the members before the resource is created, are removed. Default value is `false`: the other members are left untouched.",
+			},
+		},
+
+		Description: "Manages the explicit list of members of a group, without managing the group itself. " +
+			"Members are added to and removed from the group one by one.",
+	}
+}
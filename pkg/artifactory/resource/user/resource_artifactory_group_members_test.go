@@ -0,0 +1,292 @@
+package user_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/acctest"
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/artifactory/resource/security"
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/artifactory/resource/user"
+	"github.com/jfrog/terraform-provider-shared/test"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"golang.org/x/exp/slices"
+)
+
+const usersEndpoint = "artifactory/api/security/users/"
+
+const groupMembersTemplate = `
+	resource "artifactory_user" "user-1" {
+	  name     = "{{ .user_prefix }}-1"
+	  email    = "{{ .user_prefix }}-1@example.com"
+	  password = "Passw0rd!"
+	  groups   = ["readers"]
+
+	  lifecycle {
+		ignore_changes = [groups]
+	  }
+	}
+
+	resource "artifactory_user" "user-2" {
+	  name     = "{{ .user_prefix }}-2"
+	  email    = "{{ .user_prefix }}-2@example.com"
+	  password = "Passw0rd!"
+
+	  lifecycle {
+		ignore_changes = [groups]
+	  }
+	}
+
+	resource "artifactory_group_members" "{{ .members_name }}" {
+	  group     = "{{ .group_name }}"
+	  members   = {{ .members }}
+	  exclusive = {{ .exclusive }}
+	}
+`
+
+func TestAccGroupMembers(t *testing.T) {
+	_, _, groupName := test.MkNames("test-group", "artifactory_group")
+	_, fqrn, membersName := test.MkNames("test-group-members", "artifactory_group_members")
+	_, _, userPrefix := test.MkNames("test-member", "artifactory_user")
+	_, _, existingUserName := test.MkNames("test-existing-member", "artifactory_user")
+
+	mkConfig := func(members string) string {
+		return util.ExecuteTemplate(fqrn, groupMembersTemplate, map[string]string{
+			"group_name":   groupName,
+			"members_name": membersName,
+			"user_prefix":  userPrefix,
+			"members":      members,
+			"exclusive":    "false",
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			// the group and its existing member are created outside of terraform, the member is kept
+			createTestGroup(t, groupName)
+			createTestUser(t, existingUserName, []string{groupName})
+		},
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			defer deleteTestGroup(t, groupName)
+			defer deleteTestUser(t, existingUserName)
+			return testAccCheckGroupMembersDestroy(groupName, []string{existingUserName})(s)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: mkConfig(`[artifactory_user.user-1.name]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "group", groupName),
+					resource.TestCheckResourceAttr(fqrn, "members.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "exclusive", "false"),
+					testAccCheckUserGroups(userPrefix+"-1", []string{groupName, "readers"}),
+					testAccCheckUserGroups(existingUserName, []string{groupName}),
+				),
+			},
+			{
+				Config: mkConfig(`[artifactory_user.user-2.name]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "members.#", "1"),
+					resource.TestCheckTypeSetElemAttr(fqrn, "members.*", userPrefix+"-2"),
+					testAccCheckUserGroups(userPrefix+"-1", []string{"readers"}),
+					testAccCheckUserGroups(userPrefix+"-2", []string{groupName}),
+					testAccCheckUserGroups(existingUserName, []string{groupName}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGroupMembers_exclusive(t *testing.T) {
+	_, _, groupName := test.MkNames("test-group", "artifactory_group")
+	_, fqrn, membersName := test.MkNames("test-group-members", "artifactory_group_members")
+	_, _, userPrefix := test.MkNames("test-member", "artifactory_user")
+	_, _, existingUserName := test.MkNames("test-existing-member", "artifactory_user")
+
+	mkConfig := func(members string) string {
+		return util.ExecuteTemplate(fqrn, groupMembersTemplate, map[string]string{
+			"group_name":   groupName,
+			"members_name": membersName,
+			"user_prefix":  userPrefix,
+			"members":      members,
+			"exclusive":    "true",
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			// the group and its existing member are created outside of terraform, the member is removed on create
+			createTestGroup(t, groupName)
+			createTestUser(t, existingUserName, []string{groupName})
+		},
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			defer deleteTestGroup(t, groupName)
+			defer deleteTestUser(t, existingUserName)
+			return testAccCheckGroupMembersDestroy(groupName, []string{})(s)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: mkConfig(`[artifactory_user.user-1.name]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "group", groupName),
+					resource.TestCheckResourceAttr(fqrn, "members.#", "1"),
+					testAccCheckUserGroups(userPrefix+"-1", []string{groupName, "readers"}),
+					testAccCheckUserGroups(existingUserName, []string{}),
+				),
+			},
+			{
+				Config: mkConfig(`[artifactory_user.user-2.name]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "members.#", "1"),
+					resource.TestCheckTypeSetElemAttr(fqrn, "members.*", userPrefix+"-2"),
+					testAccCheckUserGroups(userPrefix+"-1", []string{"readers"}),
+					testAccCheckUserGroups(userPrefix+"-2", []string{groupName}),
+				),
+			},
+			{
+				ResourceName:            fqrn,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"exclusive"},
+			},
+		},
+	})
+}
+
+func TestAccUserGroupMembership(t *testing.T) {
+	_, fqrn, name := test.MkNames("test-membership", "artifactory_user_group_membership")
+	_, _, groupName := test.MkNames("test-group", "artifactory_group")
+	_, _, userName := test.MkNames("test-member", "artifactory_user")
+
+	config := util.ExecuteTemplate(fqrn, `
+		resource "artifactory_user_group_membership" "{{ .name }}" {
+		  user  = "{{ .user_name }}"
+		  group = "{{ .group_name }}"
+		}
+	`, map[string]string{
+		"name":       name,
+		"group_name": groupName,
+		"user_name":  userName,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			// the user and the group outlive the membership, so its removal can be checked on destroy
+			createTestGroup(t, groupName)
+			createTestUser(t, userName, []string{"readers"})
+		},
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			defer deleteTestGroup(t, groupName)
+			defer deleteTestUser(t, userName)
+			return testAccCheckUserGroups(userName, []string{"readers"})(s)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "id", fmt.Sprintf("%s:%s", userName, groupName)),
+					testAccCheckUserGroups(userName, []string{groupName, "readers"}),
+				),
+			},
+			{
+				ResourceName:      fqrn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestApplyGroupChanges(t *testing.T) {
+	testCases := []struct {
+		groups, add, remove, expected []string
+	}{
+		{groups: []string{"readers"}, add: []string{"dev"}, expected: []string{"dev", "readers"}},
+		{groups: []string{"readers", "dev"}, remove: []string{"dev"}, expected: []string{"readers"}},
+		{groups: []string{"readers"}, add: []string{"readers"}, expected: []string{"readers"}},
+		{groups: nil, remove: []string{"dev"}, expected: []string{}},
+		{groups: []string{"b", "a"}, expected: []string{"a", "b"}},
+	}
+
+	for _, tc := range testCases {
+		actual := user.ApplyGroupChanges(tc.groups, tc.add, tc.remove)
+		if !slices.Equal(actual, tc.expected) {
+			t.Errorf("ApplyGroupChanges(%v, %v, %v) = %v, expected %v", tc.groups, tc.add, tc.remove, actual, tc.expected)
+		}
+	}
+}
+
+func testAccCheckUserGroups(userName string, expected []string) func(*terraform.State) error {
+	return func(_ *terraform.State) error {
+		client := acctest.Provider.Meta().(*resty.Client)
+
+		result := user.User{}
+		if _, err := client.R().SetResult(&result).Get(usersEndpoint + userName); err != nil {
+			return err
+		}
+
+		groups := user.ApplyGroupChanges(result.Groups, nil, nil)
+		if !slices.Equal(groups, expected) {
+			return fmt.Errorf("error: user %s has groups %v, expected %v", userName, groups, expected)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckGroupMembersDestroy checks that the group, which outlives artifactory_group_members, only has the members
+// not managed by the resource left
+func testAccCheckGroupMembersDestroy(groupName string, remaining []string) func(*terraform.State) error {
+	return func(_ *terraform.State) error {
+		client := acctest.Provider.Meta().(*resty.Client)
+
+		group := security.Group{}
+		if _, err := client.R().SetResult(&group).Get(security.GroupsEndpoint + groupName + "?includeUsers=true"); err != nil {
+			return err
+		}
+
+		if !slices.Equal(group.UsersNames, remaining) && len(group.UsersNames)+len(remaining) > 0 {
+			return fmt.Errorf("error: group %s still has members %v", groupName, group.UsersNames)
+		}
+
+		return nil
+	}
+}
+
+func createTestGroup(t *testing.T, groupName string) {
+	group := security.Group{Name: groupName}
+	if _, err := acctest.GetTestResty(t).R().SetBody(group).Put(security.GroupsEndpoint + groupName); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func deleteTestGroup(t *testing.T, groupName string) {
+	if _, err := acctest.GetTestResty(t).R().Delete(security.GroupsEndpoint + groupName); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func createTestUser(t *testing.T, userName string, groups []string) {
+	body := user.User{
+		Name:     userName,
+		Email:    userName + "@example.com",
+		Password: "Passw0rd!",
+		Groups:   groups,
+	}
+	if _, err := acctest.GetTestResty(t).R().SetBody(body).Put(usersEndpoint + userName); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func deleteTestUser(t *testing.T, userName string) {
+	if _, err := acctest.GetTestResty(t).R().Delete(usersEndpoint + userName); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,288 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"golang.org/x/exp/slices"
+)
+
+// Users that are never cleaned up by the inactive users policy
+var protectedUsers = []string{"anonymous"}
+
+// IsInactiveUser returns true when the user is not an admin, not excluded and didn't log in since the threshold.
+// Users that never logged in are inactive only when includeNeverLoggedIn is set.
+func IsInactiveUser(user User, threshold time.Time, includeNeverLoggedIn bool, excluded []string) bool {
+	if user.Admin || slices.Contains(excluded, user.Name) || slices.Contains(protectedUsers, user.Name) {
+		return false
+	}
+
+	if user.LastLoggedIn == "" {
+		return includeNeverLoggedIn
+	}
+
+	lastLoggedIn, err := time.Parse(time.RFC3339, user.LastLoggedIn)
+	if err != nil {
+		// an unknown format must never lead to deleting a user
+		return false
+	}
+
+	return lastLoggedIn.Before(threshold)
+}
+
+// inactiveUsersPolicyData common methods of schema.ResourceData and schema.ResourceDiff used to find the inactive users
+type inactiveUsersPolicyData interface {
+	Get(string) interface{}
+}
+
+func ResourceArtifactoryInactiveUsersPolicy() *schema.Resource {
+	var findInactiveUsers = func(c *resty.Client, d inactiveUsersPolicyData) ([]User, error) {
+		var list []struct {
+			Name string `json:"name"`
+		}
+		if _, err := c.R().SetResult(&list).Get(strings.TrimSuffix(usersEndpointPath, "/")); err != nil {
+			return nil, err
+		}
+
+		names := make([]string, 0, len(list))
+		for _, user := range list {
+			names = append(names, user.Name)
+		}
+
+		threshold := time.Now().AddDate(0, 0, -d.Get("inactive_days").(int))
+		includeNeverLoggedIn := d.Get("include_never_logged_in").(bool)
+		excluded := util.CastToStringArr(d.Get("exclude").(*schema.Set).List())
+		disable := d.Get("action").(string) == "disable"
+
+		var mutex sync.Mutex
+		var inactiveUsers []User
+		errs := ForEachBounded(5, names, func(name string) error {
+			user, err := readUser(c, name)
+			if err != nil {
+				return err
+			}
+			// users disabled by a previous apply don't match anymore
+			if disable && user.DisableUIAccess && user.InternalPasswordDisabled {
+				return nil
+			}
+			if IsInactiveUser(*user, threshold, includeNeverLoggedIn, excluded) {
+				mutex.Lock()
+				inactiveUsers = append(inactiveUsers, *user)
+				mutex.Unlock()
+			}
+			return nil
+		})
+		for _, err := range errs {
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		sort.Slice(inactiveUsers, func(i, j int) bool {
+			return inactiveUsers[i].Name < inactiveUsers[j].Name
+		})
+
+		return inactiveUsers, nil
+	}
+
+	var userNames = func(users []User) []string {
+		names := make([]string, 0, len(users))
+		for _, user := range users {
+			names = append(names, user.Name)
+		}
+		return names
+	}
+
+	var resourceInactiveUsersPolicyRead = func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		var diags diag.Diagnostics
+		names := []string{}
+		inactiveUsers, err := findInactiveUsers(m.(*resty.Client), d)
+		if err != nil {
+			// no user is cleaned up until the inactive users can be listed again
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Failed to list the inactive users, no user is cleaned up",
+				Detail:   err.Error(),
+			})
+		} else {
+			names = userNames(inactiveUsers)
+		}
+
+		setValue := util.MkLens(d)
+		errors := setValue("inactive_users", names)
+		if errors != nil && len(errors) > 0 {
+			return append(diags, diag.Errorf("failed to pack inactive users %q", errors)...)
+		}
+
+		return diags
+	}
+
+	// resourceInactiveUsersPolicyUpdate only cleans up the users listed in `inactive_users` by the plan, and keeps them
+	// in the state so the result matches the plan. The users matching the policy since the plan are cleaned up by the
+	// next apply.
+	var resourceInactiveUsersPolicyUpdate = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		c := m.(*resty.Client)
+
+		d.SetId("inactive_users_policy")
+
+		if d.Get("report_only").(bool) {
+			if err := d.Set("processed_users", []string{}); err != nil {
+				return diag.FromErr(err)
+			}
+			return nil
+		}
+
+		plannedUsers := util.CastToStringArr(d.Get("inactive_users").(*schema.Set).List())
+		sort.Strings(plannedUsers)
+
+		action := d.Get("action").(string)
+		var mutex sync.Mutex
+		processed := []string{}
+		errs := ForEachBounded(5, plannedUsers, func(name string) error {
+			unlock := lockUser(name)
+			defer unlock()
+
+			if action == "delete" {
+				if _, err := c.R().Delete(usersEndpointPath + name); err != nil {
+					return err
+				}
+			} else {
+				user, err := readUser(c, name)
+				if err != nil {
+					return err
+				}
+				user.DisableUIAccess = true
+				user.InternalPasswordDisabled = true
+				user.LastLoggedIn = ""
+				if _, err := c.R().SetBody(user).Post(usersEndpointPath + name); err != nil {
+					return err
+				}
+			}
+
+			tflog.Info(ctx, fmt.Sprintf("inactive user %s: %s", name, action))
+			mutex.Lock()
+			processed = append(processed, name)
+			mutex.Unlock()
+			return nil
+		})
+		sort.Strings(processed)
+
+		var diags diag.Diagnostics
+		for name, err := range errs {
+			if err != nil {
+				diags = append(diags, diag.Errorf("failed to %s inactive user %s: %s", action, name, err)...)
+			}
+		}
+
+		if err := d.Set("processed_users", processed); err != nil {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+
+		return diags
+	}
+
+	var resourceInactiveUsersPolicyDelete = func(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+		// the policy is only applied by terraform, there is nothing to remove in Artifactory
+		d.SetId("")
+		return nil
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceInactiveUsersPolicyUpdate,
+		ReadContext:   resourceInactiveUsersPolicyRead,
+		UpdateContext: resourceInactiveUsersPolicyUpdate,
+		DeleteContext: resourceInactiveUsersPolicyDelete,
+
+		// the users matching the policy are listed by the plan, and cleaned up by the apply unless in report mode
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+			for _, key := range []string{"inactive_days", "action", "exclude", "include_never_logged_in", "report_only"} {
+				if !diff.NewValueKnown(key) {
+					return fmt.Errorf("%s must be known when planning, the plan lists the users that the apply cleans up", key)
+				}
+			}
+
+			// the refresh already lists the inactive users with the applied policy, they are only listed again when
+			// the policy changes
+			if diff.Id() == "" || diff.HasChanges("inactive_days", "action", "exclude", "include_never_logged_in") {
+				names := []string{}
+				inactiveUsers, err := findInactiveUsers(m.(*resty.Client), diff)
+				if err != nil {
+					// an error must never fail the plan nor lead to cleaning up users, none are cleaned up by this apply
+					tflog.Warn(ctx, fmt.Sprintf("failed to list the inactive users, no user is cleaned up: %s", err))
+				} else {
+					names = userNames(inactiveUsers)
+				}
+				if err := diff.SetNew("inactive_users", names); err != nil {
+					return err
+				}
+			}
+
+			if !diff.Get("report_only").(bool) && diff.Get("inactive_users").(*schema.Set).Len() > 0 {
+				return diff.SetNewComputed("processed_users")
+			}
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			"inactive_days": {
+				Type:             schema.TypeInt,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Description:      "Number of days without login after which a user is inactive.",
+			},
+			"action": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "disable",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"disable", "delete"}, false)),
+				Description: "Action applied to the inactive users: 'disable' disables their internal password and UI access, " +
+					"'delete' deletes them. Default value is 'disable'.",
+			},
+			"exclude": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Optional:    true,
+				Description: "Names of the users that are never cleaned up. Admins and the anonymous user are always excluded.",
+			},
+			"include_never_logged_in": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Also clean up the users that never logged in. Default value is 'false'.",
+			},
+			"report_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Only report the inactive users in `inactive_users`, without disabling or deleting them. Default value is 'false'.",
+			},
+			"inactive_users": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Computed:    true,
+				Description: "Names of the users matching the policy, listed by the plan. The apply cleans up these users only.",
+			},
+			"processed_users": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "Names of the users disabled or deleted by the last apply.",
+			},
+		},
+
+		Description: "Disables or deletes the non-admin users that didn't log in for a number of days. The policy is applied " +
+			"on each apply, the users found by the plan are shown in `inactive_users` and are the only ones cleaned up.",
+	}
+}
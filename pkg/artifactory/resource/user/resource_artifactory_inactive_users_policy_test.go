@@ -0,0 +1,115 @@
+package user_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/acctest"
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/artifactory/resource/user"
+	"github.com/jfrog/terraform-provider-shared/test"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccInactiveUsersPolicy_ReportOnly(t *testing.T) {
+	_, fqrn, name := test.MkNames("test-inactive-users", "artifactory_inactive_users_policy")
+	_, _, userName := test.MkNames("test-never-logged-in", "artifactory_user")
+	_, _, excludedName := test.MkNames("test-excluded", "artifactory_user")
+
+	config := util.ExecuteTemplate(fqrn, `
+		resource "artifactory_user" "{{ .user_name }}" {
+		  name     = "{{ .user_name }}"
+		  email    = "{{ .user_name }}@example.com"
+		  password = "Passw0rd!"
+		}
+
+		resource "artifactory_user" "{{ .excluded_name }}" {
+		  name     = "{{ .excluded_name }}"
+		  email    = "{{ .excluded_name }}@example.com"
+		  password = "Passw0rd!"
+		}
+
+		resource "artifactory_inactive_users_policy" "{{ .name }}" {
+		  inactive_days           = 30
+		  include_never_logged_in = true
+		  exclude                 = [artifactory_user.{{ .excluded_name }}.name]
+		  report_only             = true
+
+		  depends_on = [artifactory_user.{{ .user_name }}]
+		}
+	`, map[string]string{
+		"name":          name,
+		"user_name":     userName,
+		"excluded_name": excludedName,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr(fqrn, "inactive_users.*", userName),
+					resource.TestCheckResourceAttr(fqrn, "processed_users.#", "0"),
+					testAccCheckUserNotInSet(fqrn, "inactive_users", excludedName),
+				),
+			},
+			{
+				// the users listed by the plan match the refreshed ones, in report mode nothing is left to apply
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestIsInactiveUser(t *testing.T) {
+	now := time.Date(2022, 9, 1, 0, 0, 0, 0, time.UTC)
+	threshold := now.AddDate(0, 0, -30)
+
+	testCases := []struct {
+		name                 string
+		user                 user.User
+		includeNeverLoggedIn bool
+		expected             bool
+	}{
+		{"inactive", user.User{Name: "alice", LastLoggedIn: "2022-06-01T10:00:00.000Z"}, false, true},
+		{"active", user.User{Name: "alice", LastLoggedIn: "2022-08-30T10:00:00.000Z"}, false, false},
+		{"admin", user.User{Name: "alice", Admin: true, LastLoggedIn: "2022-06-01T10:00:00.000Z"}, false, false},
+		{"excluded", user.User{Name: "bob", LastLoggedIn: "2022-06-01T10:00:00.000Z"}, false, false},
+		{"anonymous", user.User{Name: "anonymous"}, true, false},
+		{"never logged in", user.User{Name: "alice"}, false, false},
+		{"never logged in included", user.User{Name: "alice"}, true, true},
+		{"unknown date format", user.User{Name: "alice", LastLoggedIn: "01/06/2022"}, true, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := user.IsInactiveUser(tc.user, threshold, tc.includeNeverLoggedIn, []string{"bob"})
+			if actual != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func testAccCheckUserNotInSet(fqrn, attribute, userName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[fqrn]
+		if !ok {
+			return fmt.Errorf("err: Resource id[%s] not found", fqrn)
+		}
+
+		for key, value := range rs.Primary.Attributes {
+			if strings.HasPrefix(key, attribute+".") && value == userName {
+				return fmt.Errorf("error: user %s should not be in %s", userName, attribute)
+			}
+		}
+
+		return nil
+	}
+}
@@ -31,6 +31,8 @@ func ResourceArtifactoryManagedUser() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: customizeUserDiff,
+
 		Schema: managedUserSchema,
 
 		Description: "Provides an Artifactory managed user resource. This can be used to create and manage Artifactory users. For example, service account where password is known and managed externally.",
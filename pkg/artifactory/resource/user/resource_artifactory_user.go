@@ -5,7 +5,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/sethvargo/go-password/password"
 	"golang.org/x/exp/maps"
 )
 
@@ -31,6 +30,8 @@ func ResourceArtifactoryUser() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: customizeUserDiff,
+
 		Schema: userSchema,
 
 		Description: "Provides an Artifactory unmanaged user resource. This can be used to create and manage Artifactory users. Password is optional and one will be automatically generated.",
@@ -49,9 +50,7 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface
 				Detail:   "One will be generated (10 characters with 1 digit, 1 symbol, with upper and lower case letters) and this may fail as your Artifactory password policy can't be determined by the provider.",
 			})
 
-			// Generate a password that is 10 characters long with 1 digit, 1 symbol,
-			// allowing upper and lower case letters, disallowing repeat characters.
-			randomPassword, err := password.Generate(10, 1, 1, false, false)
+			randomPassword, err := generatePassword()
 			if err != nil {
 				return diag.Errorf("failed to generate password. %v", err)
 			}
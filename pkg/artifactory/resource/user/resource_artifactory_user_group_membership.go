@@ -0,0 +1,206 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/artifactory/resource/security"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"golang.org/x/exp/slices"
+)
+
+// Group memberships of the same user are serialized within the provider, the API only supports replacing all the
+// groups of a user
+var userLocks sync.Map
+
+func lockUser(name string) func() {
+	lock, _ := userLocks.LoadOrStore(name, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	return lock.(*sync.Mutex).Unlock
+}
+
+// ApplyGroupChanges returns the groups with the added groups and without the removed groups, sorted.
+// The other groups are left untouched.
+func ApplyGroupChanges(groups, add, remove []string) []string {
+	result := []string{}
+	for _, group := range groups {
+		if !slices.Contains(remove, group) && !slices.Contains(result, group) {
+			result = append(result, group)
+		}
+	}
+	for _, group := range add {
+		if !slices.Contains(remove, group) && !slices.Contains(result, group) {
+			result = append(result, group)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// updateUserGroups adds and removes groups of the user, with a read-modify-write of the user
+func updateUserGroups(c *resty.Client, userName string, add, remove []string) error {
+	unlock := lockUser(userName)
+	defer unlock()
+
+	user := User{}
+	resp, err := c.R().SetResult(&user).Get(usersEndpointPath + userName)
+	if err != nil {
+		// a deleted user is not a member of any group anymore
+		if resp != nil && resp.StatusCode() == http.StatusNotFound && len(add) == 0 {
+			return nil
+		}
+		return fmt.Errorf("failed to read user %s: %s", userName, err)
+	}
+
+	groups := ApplyGroupChanges(user.Groups, add, remove)
+	if slices.Equal(groups, ApplyGroupChanges(user.Groups, nil, nil)) {
+		return nil
+	}
+
+	// the user is sent back unchanged, except its groups
+	user.Groups = groups
+	user.LastLoggedIn = ""
+	if _, err := c.R().SetBody(user).Post(usersEndpointPath + userName); err != nil {
+		return fmt.Errorf("failed to update groups of user %s: %s", userName, err)
+	}
+
+	return nil
+}
+
+// groupMembers returns the names of the members of the group, and false if the group doesn't exist
+func groupMembers(c *resty.Client, groupName string) ([]string, bool, error) {
+	group := security.Group{}
+	resp, err := c.R().SetResult(&group).Get(security.GroupsEndpoint + groupName + "?includeUsers=true")
+	if err != nil {
+		if resp != nil && (resp.StatusCode() == http.StatusBadRequest || resp.StatusCode() == http.StatusNotFound) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	return group.UsersNames, true, nil
+}
+
+// membershipRemovedWarning is returned when a membership managed by the provider has been removed by another
+// resource or client. This usually means `artifactory_user.groups` or `artifactory_group.users_names` also manages it.
+func membershipRemovedWarning(userName, groupName string) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("User %s was removed from group %s outside of this resource", userName, groupName),
+		Detail: fmt.Sprintf("The membership will be added again on the next apply. If artifactory_user.%s sets `groups` or "+
+			"artifactory_group.%s sets `users_names`, both resources manage the same membership and keep overwriting "+
+			"each other. Add the group to `groups`, or remove the attribute from the other resource.", userName, groupName),
+	}
+}
+
+// membershipAddedWarning is returned when a user has been added outside of artifactory_group_members to a group it
+// manages. This usually means `artifactory_user.groups` or `artifactory_group.users_names` also manages it.
+func membershipAddedWarning(userName, groupName string) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("User %s was added to group %s outside of this resource", userName, groupName),
+		Detail: fmt.Sprintf("The membership will be removed on the next apply. If artifactory_user.%s sets `groups` or "+
+			"artifactory_group.%s sets `users_names`, both resources manage the same membership and keep overwriting "+
+			"each other. Add the user to `members`, or remove the attribute from the other resource.", userName, groupName),
+	}
+}
+
+func ResourceArtifactoryUserGroupMembership() *schema.Resource {
+	var parseId = func(id string) (string, string, error) {
+		parts := strings.SplitN(id, ":", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return "", "", fmt.Errorf("invalid user group membership ID %s, expected <user>:<group>", id)
+		}
+		return parts[0], parts[1], nil
+	}
+
+	var resourceUserGroupMembershipRead = func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		userName, groupName, err := parseId(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		user := User{}
+		resp, err := m.(*resty.Client).R().SetResult(&user).Get(usersEndpointPath + userName)
+		if err != nil {
+			if resp != nil && resp.StatusCode() == http.StatusNotFound {
+				d.SetId("")
+				return nil
+			}
+			return diag.FromErr(err)
+		}
+
+		if !slices.Contains(user.Groups, groupName) {
+			d.SetId("")
+			return diag.Diagnostics{membershipRemovedWarning(userName, groupName)}
+		}
+
+		setValue := util.MkLens(d)
+		setValue("user", userName)
+		errors := setValue("group", groupName)
+		if errors != nil && len(errors) > 0 {
+			return diag.Errorf("failed to pack user group membership %q", errors)
+		}
+
+		return nil
+	}
+
+	var resourceUserGroupMembershipCreate = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		userName := d.Get("user").(string)
+		groupName := d.Get("group").(string)
+
+		if err := updateUserGroups(m.(*resty.Client), userName, []string{groupName}, nil); err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId(fmt.Sprintf("%s:%s", userName, groupName))
+		return resourceUserGroupMembershipRead(ctx, d, m)
+	}
+
+	var resourceUserGroupMembershipDelete = func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		userName, groupName, err := parseId(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		err = updateUserGroups(m.(*resty.Client), userName, nil, []string{groupName})
+		return diag.FromErr(err)
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceUserGroupMembershipCreate,
+		ReadContext:   resourceUserGroupMembershipRead,
+		DeleteContext: resourceUserGroupMembershipDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"user": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringDoesNotContainAny(":")),
+				Description:      "Name of the user.",
+			},
+			"group": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+				Description:      "Name of the group.",
+			},
+		},
+
+		Description: "Adds a single user to a single group. The other groups of the user and the other members of the group are left untouched.",
+	}
+}
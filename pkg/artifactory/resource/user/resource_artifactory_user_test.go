@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"github.com/jfrog/terraform-provider-shared/util"
 	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/acctest"
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/artifactory/resource/user"
 	"github.com/jfrog/terraform-provider-shared/test"
 )
 
@@ -269,3 +271,108 @@ func testAccCheckUserDestroy(id string) func(*terraform.State) error {
 		return fmt.Errorf("error: User %s still exists", rs.Primary.ID)
 	}
 }
+
+func TestAccUser_Lifecycle(t *testing.T) {
+	_, fqrn, name := test.MkNames("test-user-lifecycle", "artifactory_user")
+
+	mkConfig := func(attributes string) string {
+		return util.ExecuteTemplate(fqrn, `
+			resource "artifactory_user" "{{ .name }}" {
+			  name     = "{{ .name }}"
+			  email    = "{{ .name }}@example.com"
+			  password = "Passw0rd!"
+			  {{ .attributes }}
+			}
+		`, map[string]string{
+			"name":       name,
+			"attributes": attributes,
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckUserDestroy(fqrn),
+		Steps: []resource.TestStep{
+			{
+				Config: mkConfig(`force_password_expire = true`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "locked", "false"),
+					resource.TestCheckResourceAttr(fqrn, "force_password_expire", "true"),
+				),
+			},
+			{
+				Config: mkConfig(`force_password_expire = false`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "force_password_expire", "false"),
+				),
+			},
+			{
+				Config:      mkConfig(`locked = true`),
+				ExpectError: regexp.MustCompile(`can't be locked`),
+			},
+		},
+	})
+}
+
+func TestAccUser_SshPublicKey(t *testing.T) {
+	const publicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAINsDtDAlUVD9AYCEYEs8zCVqIOqT11pwqPxjUqR8H99Q test@example.com"
+	_, fqrn, name := test.MkNames("test-user-ssh", "artifactory_user")
+
+	mkConfig := func(attribute string) string {
+		return util.ExecuteTemplate(fqrn, `
+			resource "artifactory_user" "{{ .name }}" {
+			  name     = "{{ .name }}"
+			  email    = "{{ .name }}@example.com"
+			  password = "Passw0rd!"
+			  {{ .attribute }}
+			}
+		`, map[string]string{
+			"name":      name,
+			"attribute": attribute,
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckUserDestroy(fqrn),
+		Steps: []resource.TestStep{
+			{
+				Config:      mkConfig(`ssh_public_key = "ssh-rsa not-a-key"`),
+				ExpectError: regexp.MustCompile(`OpenSSH authorized key format`),
+			},
+			{
+				Config: mkConfig(fmt.Sprintf(`ssh_public_key = "%s"`, publicKey)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "ssh_public_key", publicKey),
+				),
+			},
+			{
+				// removing the attribute removes the key of the user
+				Config: mkConfig(""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "ssh_public_key", ""),
+					testAccCheckUserSshPublicKey(name, ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckUserSshPublicKey(userName, expected string) func(*terraform.State) error {
+	return func(_ *terraform.State) error {
+		client := acctest.Provider.Meta().(*resty.Client)
+
+		result := user.User{}
+		if _, err := client.R().SetResult(&result).Get(usersEndpoint + userName); err != nil {
+			return err
+		}
+
+		if result.GetSshPublicKey() != expected {
+			return fmt.Errorf("error: user %s has SSH public key %q, expected %q", userName, result.GetSshPublicKey(), expected)
+		}
+
+		return nil
+	}
+}
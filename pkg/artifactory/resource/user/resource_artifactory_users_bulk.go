@@ -0,0 +1,416 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/jfrog/terraform-provider-shared/validator"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+// Results of the users of an artifactory_users_bulk resource
+const (
+	BulkUserCreated   = "created"
+	BulkUserUpdated   = "updated"
+	BulkUserUnchanged = "unchanged"
+)
+
+const internalRealm = "internal"
+
+// ForEachBounded calls f for each name, with at most concurrency calls running at the same time, and returns the
+// error of each call
+func ForEachBounded(concurrency int, names []string, f func(name string) error) map[string]error {
+	errs := make(map[string]error, len(names))
+
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, concurrency)
+
+	for _, name := range names {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(name string) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			err := f(name)
+
+			mutex.Lock()
+			errs[name] = err
+			mutex.Unlock()
+		}(name)
+	}
+	wg.Wait()
+
+	return errs
+}
+
+func ResourceArtifactoryUsersBulk() *schema.Resource {
+	var unpackBulkUsers = func(set *schema.Set) map[string]User {
+		users := map[string]User{}
+		for _, raw := range set.List() {
+			u := raw.(map[string]interface{})
+			realm := u["realm"].(string)
+			users[u["name"].(string)] = User{
+				Name:                     u["name"].(string),
+				Email:                    u["email"].(string),
+				Admin:                    u["admin"].(bool),
+				Realm:                    realm,
+				Groups:                   util.CastToStringArr(u["groups"].(*schema.Set).List()),
+				ProfileUpdatable:         u["profile_updatable"].(bool),
+				DisableUIAccess:          u["disable_ui_access"].(bool),
+				InternalPasswordDisabled: realm != internalRealm,
+			}
+		}
+		return users
+	}
+
+	var packBulkUser = func(user *User) map[string]interface{} {
+		groups := user.Groups
+		if groups == nil {
+			groups = []string{}
+		}
+		realm := user.Realm
+		if realm == "" {
+			realm = internalRealm
+		}
+		return map[string]interface{}{
+			"name":              user.Name,
+			"email":             user.Email,
+			"admin":             user.Admin,
+			"profile_updatable": user.ProfileUpdatable,
+			"disable_ui_access": user.DisableUIAccess,
+			"realm":             realm,
+			"groups":            schema.NewSet(schema.HashString, util.CastToInterfaceArr(groups)),
+		}
+	}
+
+	var sameUser = func(a, b User) bool {
+		groupsA, groupsB := slices.Clone(a.Groups), slices.Clone(b.Groups)
+		sort.Strings(groupsA)
+		sort.Strings(groupsB)
+		return a.Email == b.Email && a.Admin == b.Admin && a.ProfileUpdatable == b.ProfileUpdatable &&
+			a.DisableUIAccess == b.DisableUIAccess && a.Realm == b.Realm && slices.Equal(groupsA, groupsB)
+	}
+
+	var createBulkUser = func(c *resty.Client, user User) error {
+		unlock := lockUser(user.Name)
+		defer unlock()
+
+		groups := user.Groups
+		if _, err := c.R().SetBody(user).Put(usersEndpointPath + user.Name); err != nil {
+			return err
+		}
+
+		// Same workaround as resourceBaseUserCreate, Artifactory adds the user to "readers" when created without groups
+		if len(groups) == 0 {
+			user.Groups = []string{}
+			if _, err := c.R().SetBody(user).Post(usersEndpointPath + user.Name); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	var updateBulkUser = func(c *resty.Client, user User) error {
+		unlock := lockUser(user.Name)
+		defer unlock()
+
+		if user.Groups == nil {
+			user.Groups = []string{}
+		}
+		_, err := c.R().SetBody(user).Post(usersEndpointPath + user.Name)
+		return err
+	}
+
+	var deleteBulkUser = func(c *resty.Client, name string) error {
+		unlock := lockUser(name)
+		defer unlock()
+
+		resp, err := c.R().Delete(usersEndpointPath + name)
+		if err != nil && resp != nil && resp.StatusCode() == http.StatusNotFound {
+			return nil
+		}
+		return err
+	}
+
+	var errorsToDiags = func(errs map[string]error, action string) diag.Diagnostics {
+		names := maps.Keys(errs)
+		sort.Strings(names)
+
+		var diags diag.Diagnostics
+		for _, name := range names {
+			if errs[name] != nil {
+				diags = append(diags, diag.Errorf("failed to %s user %s: %s", action, name, errs[name])...)
+			}
+		}
+		return diags
+	}
+
+	var resourceUsersBulkRead = func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		c := m.(*resty.Client)
+		names := maps.Keys(unpackBulkUsers(d.Get("user").(*schema.Set)))
+
+		var mutex sync.Mutex
+		users := map[string]*User{}
+		errs := ForEachBounded(d.Get("concurrency").(int), names, func(name string) error {
+			user := &User{}
+			resp, err := c.R().SetResult(user).Get(usersEndpointPath + name)
+			if err != nil {
+				if resp != nil && resp.StatusCode() == http.StatusNotFound {
+					// the user is created again on the next apply
+					return nil
+				}
+				return err
+			}
+
+			mutex.Lock()
+			users[name] = user
+			mutex.Unlock()
+			return nil
+		})
+		if diags := errorsToDiags(errs, "read"); diags != nil {
+			return diags
+		}
+
+		packed := make([]interface{}, 0, len(users))
+		for _, user := range users {
+			packed = append(packed, packBulkUser(user))
+		}
+
+		setValue := util.MkLens(d)
+		errors := setValue("user", packed)
+		if errors != nil && len(errors) > 0 {
+			return diag.Errorf("failed to pack users %q", errors)
+		}
+
+		return nil
+	}
+
+	var resourceUsersBulkUpdate = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		c := m.(*resty.Client)
+
+		oldSet, newSet := d.GetChange("user")
+		current := unpackBulkUsers(oldSet.(*schema.Set))
+		desired := unpackBulkUsers(newSet.(*schema.Set))
+
+		oldPasswords, _ := d.GetChange("passwords")
+		passwords := map[string]interface{}{}
+		for name, password := range oldPasswords.(map[string]interface{}) {
+			if _, ok := desired[name]; ok {
+				passwords[name] = password
+			}
+		}
+
+		var mutex sync.Mutex
+		results := map[string]interface{}{}
+		errs := ForEachBounded(d.Get("concurrency").(int), maps.Keys(desired), func(name string) error {
+			user := desired[name]
+
+			result := BulkUserUnchanged
+			if existing, found := current[name]; !found {
+				if user.Realm == internalRealm {
+					password, err := generatePassword()
+					if err != nil {
+						return fmt.Errorf("failed to generate password: %s", err)
+					}
+					user.Password = password
+				}
+				if err := createBulkUser(c, user); err != nil {
+					return err
+				}
+				result = BulkUserCreated
+			} else if !sameUser(existing, user) {
+				if err := updateBulkUser(c, user); err != nil {
+					return err
+				}
+				result = BulkUserUpdated
+			}
+
+			mutex.Lock()
+			results[name] = result
+			if user.Password != "" {
+				passwords[name] = user.Password
+			}
+			mutex.Unlock()
+			return nil
+		})
+		diags := errorsToDiags(errs, "apply")
+
+		var removed []string
+		for name := range current {
+			if _, ok := desired[name]; !ok {
+				removed = append(removed, name)
+			}
+		}
+		diags = append(diags, errorsToDiags(ForEachBounded(d.Get("concurrency").(int), removed, func(name string) error {
+			return deleteBulkUser(c, name)
+		}), "delete")...)
+
+		for name, err := range errs {
+			if err != nil {
+				results[name] = fmt.Sprintf("error: %s", err)
+			}
+		}
+
+		// the users of the bulk change over time, the ID doesn't depend on them
+		if d.Id() == "" {
+			d.SetId(resource.UniqueId())
+		}
+
+		setValue := util.MkLens(d)
+		setValue("results", results)
+		errors := setValue("passwords", passwords)
+		if errors != nil && len(errors) > 0 {
+			return append(diags, diag.Errorf("failed to pack users %q", errors)...)
+		}
+
+		if diags.HasError() {
+			return diags
+		}
+
+		return resourceUsersBulkRead(ctx, d, m)
+	}
+
+	var resourceUsersBulkDelete = func(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		c := m.(*resty.Client)
+		names := maps.Keys(unpackBulkUsers(d.Get("user").(*schema.Set)))
+
+		return errorsToDiags(ForEachBounded(d.Get("concurrency").(int), names, func(name string) error {
+			return deleteBulkUser(c, name)
+		}), "delete")
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceUsersBulkUpdate,
+		ReadContext:   resourceUsersBulkRead,
+		UpdateContext: resourceUsersBulkUpdate,
+		DeleteContext: resourceUsersBulkDelete,
+
+		CustomizeDiff: customdiff.All(
+			// the user blocks differing by another attribute than the name are distinct elements of the set, so the
+			// duplicates would silently overwrite each other
+			func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+				var duplicates []string
+				names := map[string]bool{}
+				for _, raw := range diff.Get("user").(*schema.Set).List() {
+					name := raw.(map[string]interface{})["name"].(string)
+					// the name is empty when it is not known yet
+					if name == "" {
+						continue
+					}
+					if names[name] && !slices.Contains(duplicates, name) {
+						duplicates = append(duplicates, name)
+					}
+					names[name] = true
+				}
+				if len(duplicates) > 0 {
+					sort.Strings(duplicates)
+					return fmt.Errorf("duplicate user names %q, each user must be defined once", duplicates)
+				}
+				return nil
+			},
+			func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+				if diff.HasChange("user") {
+					if err := diff.SetNewComputed("results"); err != nil {
+						return err
+					}
+					return diff.SetNewComputed("passwords")
+				}
+				return nil
+			},
+		),
+
+		Schema: map[string]*schema.Schema{
+			"user": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+							Description:      "Username of the user.",
+						},
+						"email": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validator.IsEmail,
+							Description:      "Email of the user.",
+						},
+						"groups": {
+							Type:        schema.TypeSet,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Optional:    true,
+							Description: "Groups the user is a member of.",
+						},
+						"admin": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "When enabled, the user is an administrator. Default value is 'false'.",
+						},
+						"profile_updatable": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "When enabled, the user can update their profile details, except for the password. Default value is 'true'.",
+						},
+						"disable_ui_access": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "When enabled, the user can only access the system through the REST API. This option cannot be set if the user is an administrator. Default value is 'true'.",
+						},
+						"realm": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          internalRealm,
+							ValidateDiagFunc: validator.LowerCase,
+							Description:      "Realm of the user, e.g. 'internal', 'ldap' or 'saml'. A password is generated for the users of the 'internal' realm only. Default value is 'internal'.",
+						},
+					},
+				},
+				Description: "Users to provision. A user is identified by its name, which must be unique, the users removed from the set are deleted.",
+			},
+			"concurrency": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          5,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 20)),
+				Description:      "Maximum number of users created, updated or deleted at the same time. Default value is 5.",
+			},
+			"results": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "Result of the last apply for each user: 'created', 'updated', 'unchanged' or the error.",
+			},
+			"passwords": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Generated passwords of the users of the 'internal' realm, by username.",
+			},
+		},
+
+		Description: "Provisions a batch of users in a single resource, with a bounded number of concurrent requests. " +
+			"Passwords are generated for the users of the internal realm.",
+	}
+}
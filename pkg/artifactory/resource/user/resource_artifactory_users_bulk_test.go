@@ -0,0 +1,134 @@
+package user_test
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/acctest"
+	"github.com/jfrog/terraform-provider-artifactory/v6/pkg/artifactory/resource/user"
+	"github.com/jfrog/terraform-provider-shared/test"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+func TestAccUsersBulk(t *testing.T) {
+	_, fqrn, name := test.MkNames("test-users-bulk", "artifactory_users_bulk")
+
+	mkConfig := func(users string) string {
+		return util.ExecuteTemplate(fqrn, `
+			locals {
+			  users = {{ .users }}
+			}
+
+			resource "artifactory_users_bulk" "{{ .name }}" {
+			  concurrency = 2
+
+			  dynamic "user" {
+				for_each = local.users
+				content {
+				  name   = user.key
+				  email  = user.value
+				  groups = ["readers"]
+				}
+			  }
+			}`, map[string]string{
+			"name":  name,
+			"users": users,
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: mkConfig(fmt.Sprintf(`{
+				  "%[1]s-1" = "%[1]s-1@example.com"
+				  "%[1]s-2" = "%[1]s-2@example.com"
+				  "%[1]s-3" = "%[1]s-3@example.com"
+				}`, name)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "user.#", "3"),
+					resource.TestCheckResourceAttr(fqrn, "results.%", "3"),
+					resource.TestCheckResourceAttr(fqrn, fmt.Sprintf("results.%s-1", name), user.BulkUserCreated),
+					resource.TestCheckResourceAttr(fqrn, "passwords.%", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(fqrn, "user.*", map[string]string{
+						"name":              name + "-1",
+						"profile_updatable": "true",
+						"disable_ui_access": "true",
+					}),
+					testAccCheckUserGroups(name+"-1", []string{"readers"}),
+				),
+			},
+			{
+				Config: mkConfig(fmt.Sprintf(`{
+				  "%[1]s-1" = "%[1]s-1@example.org"
+				  "%[1]s-2" = "%[1]s-2@example.com"
+				}`, name)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "user.#", "2"),
+					resource.TestCheckResourceAttr(fqrn, fmt.Sprintf("results.%s-1", name), user.BulkUserUpdated),
+					resource.TestCheckResourceAttr(fqrn, fmt.Sprintf("results.%s-2", name), user.BulkUserUnchanged),
+					resource.TestCheckResourceAttr(fqrn, "passwords.%", "2"),
+				),
+			},
+			{
+				Config: util.ExecuteTemplate(fqrn, `
+					resource "artifactory_users_bulk" "{{ .name }}" {
+					  user {
+						name  = "{{ .name }}-1"
+						email = "{{ .name }}-1@example.org"
+					  }
+
+					  user {
+						name  = "{{ .name }}-1"
+						email = "{{ .name }}-1@example.net"
+					  }
+					}`, map[string]string{
+					"name": name,
+				}),
+				ExpectError: regexp.MustCompile(".*duplicate user names.*"),
+			},
+		},
+	})
+}
+
+func TestForEachBounded(t *testing.T) {
+	names := []string{"a", "b", "c", "d", "e", "f", "g", "h"}
+
+	var mutex sync.Mutex
+	running, maxRunning := 0, 0
+
+	errs := user.ForEachBounded(3, names, func(name string) error {
+		mutex.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mutex.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mutex.Lock()
+		running--
+		mutex.Unlock()
+
+		if name == "c" {
+			return fmt.Errorf("failed")
+		}
+		return nil
+	})
+
+	if maxRunning > 3 {
+		t.Errorf("expected at most 3 concurrent calls, got %d", maxRunning)
+	}
+	if len(errs) != len(names) {
+		t.Errorf("expected a result for each of the %d names, got %d", len(names), len(errs))
+	}
+	if errs["c"] == nil || errs["a"] != nil {
+		t.Errorf("unexpected errors %v", errs)
+	}
+}
@@ -4,14 +4,19 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/jfrog/terraform-provider-shared/util"
 	"github.com/jfrog/terraform-provider-shared/validator"
+	"github.com/sethvargo/go-password/password"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/exp/slices"
 )
 
 type User struct {
@@ -25,6 +30,16 @@ type User struct {
 	LastLoggedIn             string   `json:"lastLoggedIn"`
 	Realm                    string   `json:"realm"`
 	Groups                   []string `json:"groups"`
+	SshPublicKey             *string  `json:"sshPublicKey,omitempty"`
+}
+
+// GetSshPublicKey returns the SSH public key of the user, or an empty string if it has none. SshPublicKey is nil when
+// the current key of the user is kept by an update, and empty when the key is removed.
+func (u User) GetSshPublicKey() string {
+	if u.SshPublicKey == nil {
+		return ""
+	}
+	return *u.SshPublicKey
 }
 
 var baseUserSchema = map[string]*schema.Schema{
@@ -77,10 +92,53 @@ var baseUserSchema = map[string]*schema.Schema{
 		Optional:    true,
 		Description: "List of groups this user is a part of.",
 	},
+	"ssh_public_key": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: validateSshPublicKey,
+		DiffSuppressFunc: func(_, old, new string, _ *schema.ResourceData) bool {
+			return strings.TrimSpace(old) == strings.TrimSpace(new)
+		},
+		Description: "SSH public key of the user in OpenSSH authorized key format, e.g. 'ssh-ed25519 AAAA... user@host'. " +
+			"Used to authenticate Git LFS and CLI clients over SSH. Removing the attribute removes the key of the user.",
+	},
+	"locked": {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Computed: true,
+		Description: "Whether the user is locked out after too many failed login attempts. Set to 'false' to unlock the user. " +
+			"Users can't be locked through the API.",
+	},
+	"force_password_expire": {
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+		Description: "(Optional, Default: false) When set, the password of the user is expired and the user must change it on the next login. " +
+			"Unsetting it unexpires the password.",
+	},
+}
+
+func validateSshPublicKey(value interface{}, _ cty.Path) diag.Diagnostics {
+	if _, _, _, _, err := ssh.ParseAuthorizedKey([]byte(strings.TrimSpace(value.(string)))); err != nil {
+		return diag.Errorf("SSH public key must be in OpenSSH authorized key format: %s", err)
+	}
+	return nil
+}
+
+// customizeUserDiff rejects locking a user, Artifactory only locks users after failed login attempts
+func customizeUserDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.HasChange("locked") {
+		if locked, ok := diff.GetOk("locked"); ok && locked.(bool) {
+			return fmt.Errorf("user %s can't be locked, 'locked' can only be set to false to unlock the user", diff.Get("name"))
+		}
+	}
+	return nil
 }
 
 func unpackUser(s *schema.ResourceData) User {
 	d := &util.ResourceData{ResourceData: s}
+	// always sent, an empty key removes the current one
+	sshPublicKey := strings.TrimSpace(d.GetString("ssh_public_key", false))
 	return User{
 		Name:                     d.GetString("name", false),
 		Email:                    d.GetString("email", false),
@@ -90,6 +148,7 @@ func unpackUser(s *schema.ResourceData) User {
 		DisableUIAccess:          d.GetBool("disable_ui_access", false),
 		InternalPasswordDisabled: d.GetBool("internal_password_disabled", false),
 		Groups:                   d.GetSet("groups"),
+		SshPublicKey:             &sshPublicKey,
 	}
 }
 
@@ -102,7 +161,8 @@ func packUser(user User, d *schema.ResourceData) diag.Diagnostics {
 	setValue("admin", user.Admin)
 	setValue("profile_updatable", user.ProfileUpdatable)
 	setValue("disable_ui_access", user.DisableUIAccess)
-	errors := setValue("internal_password_disabled", user.InternalPasswordDisabled)
+	setValue("internal_password_disabled", user.InternalPasswordDisabled)
+	errors := setValue("ssh_public_key", user.GetSshPublicKey())
 
 	if user.Groups != nil {
 		errors = setValue("groups", schema.NewSet(schema.HashString, util.CastToInterfaceArr(user.Groups)))
@@ -116,6 +176,48 @@ func packUser(user User, d *schema.ResourceData) diag.Diagnostics {
 }
 
 const usersEndpointPath = "artifactory/api/security/users/"
+const lockedUsersEndpoint = "artifactory/api/security/lockedUsers"
+const unlockUserEndpoint = "artifactory/api/security/unlockUsers/"
+const expirePasswordEndpoint = "artifactory/api/security/users/authorization/expirePassword/"
+const unexpirePasswordEndpoint = "artifactory/api/security/users/authorization/unexpirePassword/"
+
+func isUserLocked(c *resty.Client, userName string) (bool, error) {
+	var lockedUsers []string
+	if _, err := c.R().SetResult(&lockedUsers).Get(lockedUsersEndpoint); err != nil {
+		return false, fmt.Errorf("failed to retrieve locked users: %s", err)
+	}
+	return slices.Contains(lockedUsers, userName), nil
+}
+
+// applyUserLifecycle unlocks the user and expires or unexpires its password, as configured
+func applyUserLifecycle(d *schema.ResourceData, c *resty.Client) diag.Diagnostics {
+	userName := d.Get("name").(string)
+
+	if d.HasChange("locked") && !d.Get("locked").(bool) {
+		if _, err := c.R().Post(unlockUserEndpoint + userName); err != nil {
+			return diag.Errorf("failed to unlock user %s: %s", userName, err)
+		}
+	}
+
+	if d.HasChange("force_password_expire") {
+		expire := d.Get("force_password_expire").(bool)
+		endpoint := unexpirePasswordEndpoint
+		if expire {
+			endpoint = expirePasswordEndpoint
+		}
+		if _, err := c.R().Post(endpoint + userName); err != nil {
+			return diag.Errorf("failed to update password expiration of user %s: %s", userName, err)
+		}
+	}
+
+	return nil
+}
+
+// generatePassword generates a password that is 10 characters long with 1 digit, 1 symbol,
+// allowing upper and lower case letters, disallowing repeat characters.
+func generatePassword() (string, error) {
+	return password.Generate(10, 1, 1, false, false)
+}
 
 func resourceUserRead(_ context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
 	d := &util.ResourceData{ResourceData: rd}
@@ -131,7 +233,26 @@ func resourceUserRead(_ context.Context, rd *schema.ResourceData, m interface{})
 		}
 		return diag.FromErr(err)
 	}
-	return packUser(*user, rd)
+
+	var diags diag.Diagnostics
+	setValue := util.MkLens(rd)
+	// the locked users can only be listed by an admin, the last known value is kept when they can't be listed
+	if locked, err := isUserLocked(m.(*resty.Client), userName); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Failed to check whether user %s is locked", userName),
+			Detail:   err.Error(),
+		})
+	} else {
+		setValue("locked", locked)
+	}
+	// the password expiration is not returned by the API, the last applied value is kept (false after an import)
+	errors := setValue("force_password_expire", d.GetBool("force_password_expire", false))
+	if errors != nil && len(errors) > 0 {
+		return diag.Errorf("failed to pack user %q", errors)
+	}
+
+	return append(diags, packUser(*user, rd)...)
 }
 
 func resourceBaseUserCreate(ctx context.Context, d *schema.ResourceData, m interface{}, passwordGenerator func(*User) diag.Diagnostics) diag.Diagnostics {
@@ -182,6 +303,14 @@ func resourceBaseUserCreate(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.FromErr(retryError)
 	}
 
+	// a new user is never locked
+	if err := d.Set("locked", false); err != nil {
+		return diag.FromErr(err)
+	}
+	if d.Get("force_password_expire").(bool) {
+		diags = append(diags, applyUserLifecycle(d, m.(*resty.Client))...)
+	}
+
 	return diags
 }
 
@@ -193,6 +322,10 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface
 		return diag.FromErr(err)
 	}
 
+	if diags := applyUserLifecycle(d, m.(*resty.Client)); diags.HasError() {
+		return diags
+	}
+
 	d.SetId(user.Name)
 	return resourceUserRead(ctx, d, m)
 }